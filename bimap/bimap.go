@@ -0,0 +1,162 @@
+// Package bimap implements a bidirectional map, that is; a one-to-one mapping between keys
+// and values that may be efficiently queried in either direction.
+//
+// The map is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package bimap
+
+import "iter"
+
+// BiMap is a bidirectional map, every key maps to exactly one value and every value
+// maps back to exactly one key.
+type BiMap[K, V comparable] struct {
+	forward  map[K]V // The key -> value mapping
+	backward map[V]K // The value -> key mapping
+}
+
+// New creates and returns a new [BiMap].
+func New[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:  make(map[K]V),
+		backward: make(map[V]K),
+	}
+}
+
+// WithCapacity creates and returns a new [BiMap] with the given capacity.
+//
+// This can be a useful performance improvement when the expected maximum size of the map
+// is known ahead of time as it eliminates the need for reallocation.
+func WithCapacity[K, V comparable](capacity int) *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:  make(map[K]V, capacity),
+		backward: make(map[V]K, capacity),
+	}
+}
+
+// Insert inserts the pair key <-> value into the map.
+//
+// Because the mapping is one-to-one, Insert overwrites any existing pair involving
+// either the key or the value. So if key previously mapped to some other value, that
+// value is removed, and likewise if value was previously mapped from some other key,
+// that key is removed.
+//
+//	m := bimap.New[int, string]()
+//	m.Insert(1, "one")
+//	m.Insert(2, "one") // 1 is removed as "one" now belongs to 2
+func (m *BiMap[K, V]) Insert(key K, value V) {
+	// nil safety
+	if m.forward == nil {
+		m.forward = make(map[K]V)
+		m.backward = make(map[V]K)
+	}
+
+	if oldValue, exists := m.forward[key]; exists {
+		delete(m.backward, oldValue)
+	}
+
+	if oldKey, exists := m.backward[value]; exists {
+		delete(m.forward, oldKey)
+	}
+
+	m.forward[key] = value
+	m.backward[value] = key
+}
+
+// GetByKey returns the value mapped to by key and a boolean to indicate
+// presence, like the standard Go map.
+func (m *BiMap[K, V]) GetByKey(key K) (value V, ok bool) {
+	value, ok = m.forward[key]
+
+	return value, ok
+}
+
+// GetByValue returns the key that maps to value and a boolean to indicate
+// presence, like the standard Go map.
+func (m *BiMap[K, V]) GetByValue(value V) (key K, ok bool) {
+	key, ok = m.backward[value]
+
+	return key, ok
+}
+
+// ContainsKey reports whether the map contains the given key.
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.forward[key]
+
+	return exists
+}
+
+// ContainsValue reports whether the map contains the given value.
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, exists := m.backward[value]
+
+	return exists
+}
+
+// RemoveByKey removes the pair with the given key from the map, returning the
+// value it mapped to and a boolean to indicate whether it was in the map to begin with.
+func (m *BiMap[K, V]) RemoveByKey(key K) (value V, existed bool) {
+	value, existed = m.forward[key]
+	if !existed {
+		return value, false
+	}
+
+	delete(m.forward, key)
+	delete(m.backward, value)
+
+	return value, true
+}
+
+// RemoveByValue removes the pair with the given value from the map, returning the
+// key that mapped to it and a boolean to indicate whether it was in the map to begin with.
+func (m *BiMap[K, V]) RemoveByValue(value V) (key K, existed bool) {
+	key, existed = m.backward[value]
+	if !existed {
+		return key, false
+	}
+
+	delete(m.backward, value)
+	delete(m.forward, key)
+
+	return key, true
+}
+
+// Inverse returns a live view of the map with keys and values swapped, so lookups
+// by value become lookups by key.
+//
+// The view shares storage with m, so no copying is done and a mutation made through
+// either one is immediately reflected in the other.
+//
+//	m := bimap.New[int, string]()
+//	m.Insert(1, "one")
+//	key, _ := m.Inverse().GetByKey("one") // 1
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	// Make sure the maps exist, otherwise the two would each lazily
+	// create their own on first insert and diverge
+	if m.forward == nil {
+		m.forward = make(map[K]V)
+		m.backward = make(map[V]K)
+	}
+
+	return &BiMap[V, K]{
+		forward:  m.backward,
+		backward: m.forward,
+	}
+}
+
+// Len returns the number of pairs in the map.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// All returns an iterator over the key, value pairs in the map.
+//
+// The order of the pairs is non-deterministic.
+func (m *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range m.forward {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
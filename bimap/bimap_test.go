@@ -0,0 +1,139 @@
+package bimap_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/bimap"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestInsertGet(t *testing.T) {
+	m := bimap.New[int, string]()
+	test.Equal(t, m.Len(), 0)
+
+	m.Insert(1, "one")
+	m.Insert(2, "two")
+
+	test.Equal(t, m.Len(), 2)
+
+	value, ok := m.GetByKey(1)
+	test.True(t, ok)
+	test.Equal(t, value, "one")
+
+	key, ok := m.GetByValue("two")
+	test.True(t, ok)
+	test.Equal(t, key, 2)
+
+	_, ok = m.GetByKey(3)
+	test.False(t, ok)
+
+	_, ok = m.GetByValue("three")
+	test.False(t, ok)
+
+	test.True(t, m.ContainsKey(1))
+	test.False(t, m.ContainsKey(3))
+	test.True(t, m.ContainsValue("one"))
+	test.False(t, m.ContainsValue("three"))
+}
+
+func TestInsertOverwrite(t *testing.T) {
+	t.Run("existing key", func(t *testing.T) {
+		m := bimap.New[int, string]()
+		m.Insert(1, "one")
+		m.Insert(1, "uno")
+
+		test.Equal(t, m.Len(), 1)
+		test.False(t, m.ContainsValue("one")) // Old value should be gone
+
+		key, ok := m.GetByValue("uno")
+		test.True(t, ok)
+		test.Equal(t, key, 1)
+	})
+
+	t.Run("existing value", func(t *testing.T) {
+		m := bimap.New[int, string]()
+		m.Insert(1, "one")
+		m.Insert(2, "one")
+
+		test.Equal(t, m.Len(), 1)
+		test.False(t, m.ContainsKey(1)) // Old key should be gone
+
+		key, ok := m.GetByValue("one")
+		test.True(t, ok)
+		test.Equal(t, key, 2)
+	})
+
+	t.Run("both", func(t *testing.T) {
+		m := bimap.New[int, string]()
+		m.Insert(1, "one")
+		m.Insert(2, "two")
+		m.Insert(1, "two") // Replaces both 1 -> one and 2 -> two
+
+		test.Equal(t, m.Len(), 1)
+		test.EqualFunc(t, maps.Collect(m.All()), map[int]string{1: "two"}, maps.Equal)
+	})
+}
+
+func TestRemove(t *testing.T) {
+	m := bimap.New[int, string]()
+	m.Insert(1, "one")
+	m.Insert(2, "two")
+
+	value, existed := m.RemoveByKey(1)
+	test.True(t, existed)
+	test.Equal(t, value, "one")
+	test.False(t, m.ContainsValue("one"))
+
+	_, existed = m.RemoveByKey(1)
+	test.False(t, existed)
+
+	key, existed := m.RemoveByValue("two")
+	test.True(t, existed)
+	test.Equal(t, key, 2)
+	test.False(t, m.ContainsKey(2))
+
+	_, existed = m.RemoveByValue("two")
+	test.False(t, existed)
+
+	test.Equal(t, m.Len(), 0)
+}
+
+func TestNotNew(t *testing.T) {
+	m := bimap.BiMap[string, int]{}
+	m.Insert("one", 1)
+
+	key, ok := m.GetByValue(1)
+	test.True(t, ok)
+	test.Equal(t, key, "one")
+}
+
+func TestInverse(t *testing.T) {
+	m := bimap.New[int, string]()
+	m.Insert(1, "one")
+	m.Insert(2, "two")
+
+	inverse := m.Inverse()
+	test.Equal(t, inverse.Len(), 2)
+
+	key, ok := inverse.GetByKey("one")
+	test.True(t, ok)
+	test.Equal(t, key, 1)
+
+	// Mutations through the view are reflected in the original
+	inverse.Insert("three", 3)
+
+	value, ok := m.GetByKey(3)
+	test.True(t, ok)
+	test.Equal(t, value, "three")
+
+	// And vice versa
+	m.RemoveByKey(1)
+	test.False(t, inverse.ContainsKey("one"))
+
+	// Inverse of the zero value should stay in sync too
+	var zero bimap.BiMap[int, string]
+
+	zero.Inverse().Insert("hello", 42)
+	test.True(t, zero.ContainsKey(42))
+}
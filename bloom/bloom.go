@@ -0,0 +1,125 @@
+// Package bloom implements a Bloom filter, a space efficient probabilistic data structure
+// for membership testing.
+//
+// A Bloom filter can report that an item is definitely not present, or that it is probably
+// present. There are no false negatives, and the rate of false positives is tunable at the
+// expense of memory.
+//
+// The filter is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package bloom
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// defaultFalsePositiveRate is used when an invalid false positive rate is passed to [New].
+const defaultFalsePositiveRate = 0.01
+
+// wordSize is the number of bits in each word of the bit array.
+const wordSize = 64
+
+// Filter is a Bloom filter.
+//
+// A Filter should be instantiated by the New function and not directly.
+type Filter struct {
+	bits   []uint64 // The bit array
+	size   uint64   // The number of bits in the bit array
+	hashes uint64   // The number of hash functions
+}
+
+// New builds and returns a new [Filter] sized to hold expectedItems with
+// a false positive rate of approximately falsePositiveRate.
+//
+// An expectedItems < 1 is treated as 1, and a falsePositiveRate outside of the
+// open interval (0, 1) is replaced by a default of 0.01 (1%).
+//
+// Adding many more than expectedItems to the filter will increase the false positive
+// rate beyond what was asked for.
+func New(expectedItems int, falsePositiveRate float64) *Filter {
+	n := float64(max(expectedItems, 1))
+
+	p := falsePositiveRate
+	if p <= 0 || p >= 1 {
+		p = defaultFalsePositiveRate
+	}
+
+	// The optimal number of bits m and hash functions k for n items and false positive rate p
+	// m = -(n * ln(p)) / ln(2)^2
+	// k = (m / n) * ln(2)
+	m := math.Ceil(-n * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/n*math.Ln2))
+
+	words := (uint64(m) + wordSize - 1) / wordSize
+
+	return &Filter{
+		bits:   make([]uint64, words),
+		size:   words * wordSize,
+		hashes: uint64(k),
+	}
+}
+
+// Add adds data to the filter.
+func (f *Filter) Add(data []byte) {
+	h1, h2 := hash(data)
+	for i := range f.hashes {
+		bit := (h1 + i*h2) % f.size
+		f.bits[bit/wordSize] |= 1 << (bit % wordSize)
+	}
+}
+
+// AddString adds s to the filter.
+func (f *Filter) AddString(s string) {
+	f.Add([]byte(s))
+}
+
+// Contains reports whether data is probably in the filter.
+//
+// A false return is definitive, data has never been added. A true return
+// means data has probably been added, subject to the filter's false positive rate.
+func (f *Filter) Contains(data []byte) bool {
+	h1, h2 := hash(data)
+	for i := range f.hashes {
+		bit := (h1 + i*h2) % f.size
+		if f.bits[bit/wordSize]&(1<<(bit%wordSize)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContainsString reports whether s is probably in the filter, see [Filter.Contains].
+func (f *Filter) ContainsString(s string) bool {
+	return f.Contains([]byte(s))
+}
+
+// Bits returns the number of bits in the filter's bit array.
+func (f *Filter) Bits() int {
+	return int(f.size)
+}
+
+// Hashes returns the number of hash functions used by the filter.
+func (f *Filter) Hashes() int {
+	return int(f.hashes)
+}
+
+// Reset clears the filter, as if nothing had ever been added.
+func (f *Filter) Reset() {
+	clear(f.bits)
+}
+
+// hash returns the two base hashes of data used to derive the filter's k hash functions
+// by double hashing i.e. h1 + i*h2.
+func hash(data []byte) (h1, h2 uint64) {
+	hasher := fnv.New64a()
+	hasher.Write(data) // fnv never returns an error
+	sum := hasher.Sum64()
+
+	h1 = sum & math.MaxUint32
+	h2 = sum >> 32 //nolint: mnd // Upper half of a 64 bit hash
+	h2 |= 1        // Ensure h2 is never zero, otherwise every probe would be h1
+
+	return h1, h2
+}
@@ -0,0 +1,78 @@
+package bloom_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/bloom"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestNew(t *testing.T) {
+	f := bloom.New(1000, 0.01)
+
+	// For n = 1000, p = 0.01, m should be ~9586 bits and k should be 7
+	test.True(t, f.Bits() >= 9586)
+	test.Equal(t, f.Hashes(), 7)
+
+	// Nonsense arguments should still produce a usable filter
+	bad := bloom.New(-1, 2)
+	bad.AddString("hello")
+	test.True(t, bad.ContainsString("hello"))
+}
+
+func TestNoFalseNegatives(t *testing.T) {
+	f := bloom.New(1000, 0.01)
+
+	for i := range 1000 {
+		f.AddString(strconv.Itoa(i))
+	}
+
+	for i := range 1000 {
+		test.True(t, f.ContainsString(strconv.Itoa(i)))
+	}
+
+	test.True(t, f.Contains([]byte("999")))
+}
+
+func TestFalsePositiveRate(t *testing.T) {
+	const n = 10000
+
+	f := bloom.New(n, 0.01)
+
+	for i := range n {
+		f.AddString(strconv.Itoa(i))
+	}
+
+	falsePositives := 0
+
+	for i := n; i < 2*n; i++ {
+		if f.ContainsString(strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+
+	// Should be about 1%, allow plenty of slack
+	rate := float64(falsePositives) / n
+	test.True(t, rate < 0.03, test.Context("false positive rate was %f", rate))
+}
+
+func TestReset(t *testing.T) {
+	f := bloom.New(100, 0.01)
+	f.AddString("hello")
+	test.True(t, f.ContainsString("hello"))
+
+	f.Reset()
+	test.False(t, f.ContainsString("hello"))
+}
+
+func BenchmarkAdd(b *testing.B) {
+	f := bloom.New(b.N, 0.01)
+	data := []byte("hello")
+
+	b.ResetTimer()
+
+	for range b.N {
+		f.Add(data)
+	}
+}
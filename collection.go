@@ -0,0 +1,30 @@
+package collections
+
+import "iter"
+
+// Collection is the behaviour shared by the containers in this module that hold a
+// sequence of items, such as [set.Set], [queue.Queue], [stack.Stack] and [list.List].
+//
+// It allows writing generic helpers that work with any of them.
+type Collection[T any] interface {
+	// Len returns the number of items in the collection.
+	Len() int
+
+	// All returns an iterator over the items in the collection, in whatever
+	// order is natural for that collection.
+	All() iter.Seq[T]
+}
+
+// ToSlice collects the items in any [Collection] into a new slice, in the order
+// they are yielded by its All method.
+//
+//	s := stack.From([]string{"hello", "there"})
+//	collections.ToSlice[string](s) // [there hello]
+func ToSlice[T any](c Collection[T]) []T {
+	items := make([]T, 0, c.Len())
+	for item := range c.All() {
+		items = append(items, item)
+	}
+
+	return items
+}
@@ -0,0 +1,32 @@
+package collections_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections"
+	"github.com/FollowTheProcess/collections/list"
+	"github.com/FollowTheProcess/collections/queue"
+	"github.com/FollowTheProcess/collections/set"
+	"github.com/FollowTheProcess/collections/stack"
+	"github.com/FollowTheProcess/test"
+)
+
+// Compile time checks that the containers satisfy Collection.
+var (
+	_ collections.Collection[int] = &set.Set[int]{}
+	_ collections.Collection[int] = &queue.Queue[int]{}
+	_ collections.Collection[int] = &stack.Stack[int]{}
+	_ collections.Collection[int] = &list.List[int]{}
+)
+
+func TestToSlice(t *testing.T) {
+	s := stack.From([]string{"hello", "there", "general"})
+	test.EqualFunc(t, collections.ToSlice[string](s), []string{"general", "there", "hello"}, slices.Equal)
+
+	q := queue.From([]string{"hello", "there"})
+	test.EqualFunc(t, collections.ToSlice[string](q), []string{"hello", "there"}, slices.Equal)
+
+	empty := collections.ToSlice[int](set.New[int]())
+	test.Equal(t, len(empty), 0)
+}
@@ -0,0 +1,122 @@
+// Package colljson implements JSON serialisation for the collections in this module as
+// plain functions, including those like [counter.Counter] that have no JSON methods of their own.
+//
+// [set.Set] and [orderedmap.Map] also implement [json.Marshaler] and [json.Unmarshaler]
+// directly, using the same encoding as the helpers here. The one difference is a nil
+// collection: the methods encode it as null, like encoding/json does for a nil slice or map,
+// whereas the helpers here encode it as an empty array or object.
+//
+// Where a collection is represented as a JSON object, its keys follow the same rules as
+// [encoding/json] does for map keys: they must be strings, integers, or implement
+// [encoding.TextMarshaler] (and [encoding.TextUnmarshaler] to be decoded).
+package colljson
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/FollowTheProcess/collections/counter"
+	"github.com/FollowTheProcess/collections/internal/jsonobject"
+	"github.com/FollowTheProcess/collections/orderedmap"
+	"github.com/FollowTheProcess/collections/set"
+)
+
+// MarshalSet encodes a [set.Set] as a JSON array.
+//
+// Sets are unordered, so the order of the items in the array is non-deterministic. A nil
+// set is encoded as an empty array.
+func MarshalSet[T comparable](s *set.Set[T]) ([]byte, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+
+	items := make([]T, 0, s.Size())
+	for item := range s.All() {
+		items = append(items, item)
+	}
+
+	return json.Marshal(items)
+}
+
+// UnmarshalSet decodes a JSON array into a new [set.Set], duplicate items in the
+// array are collapsed.
+func UnmarshalSet[T comparable](data []byte) (*set.Set[T], error) {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("could not decode set: %w", err)
+	}
+
+	return set.From(items), nil
+}
+
+// MarshalOrderedMap encodes an [orderedmap.Map] as a JSON object, with the entries
+// emitted in insertion order.
+func MarshalOrderedMap[K comparable, V any](m *orderedmap.Map[K, V]) ([]byte, error) {
+	if m == nil {
+		return []byte("{}"), nil
+	}
+
+	return jsonobject.Encode(m.All())
+}
+
+// UnmarshalOrderedMap decodes a JSON object into a new [orderedmap.Map], inserting the
+// entries in the order they appear in data.
+//
+// If a key appears more than once, the last value wins but the key keeps the
+// position it was first seen in.
+func UnmarshalOrderedMap[K comparable, V any](data []byte) (*orderedmap.Map[K, V], error) {
+	m := orderedmap.New[K, V]()
+
+	err := jsonobject.Decode(data, func(key K, dec *json.Decoder) error {
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		m.Insert(key, value)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not decode ordered map: %w", err)
+	}
+
+	return m, nil
+}
+
+// MarshalCounter encodes a [counter.Counter] as a JSON object of item to count, with
+// the most common items first.
+func MarshalCounter[T comparable](c *counter.Counter[T]) ([]byte, error) {
+	if c == nil {
+		return []byte("{}"), nil
+	}
+
+	return jsonobject.Encode(c.Descending())
+}
+
+// UnmarshalCounter decodes a JSON object of item to count into a new [counter.Counter].
+//
+// Counts must not be negative, an item with a count of 0 is skipped.
+func UnmarshalCounter[T comparable](data []byte) (*counter.Counter[T], error) {
+	c := counter.New[T]()
+
+	err := jsonobject.Decode(data, func(item T, dec *json.Decoder) error {
+		var count int
+		if err := dec.Decode(&count); err != nil {
+			return err
+		}
+
+		if count < 0 {
+			return fmt.Errorf("negative count %d for item %v", count, item)
+		}
+
+		c.AddN(item, count)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not decode counter: %w", err)
+	}
+
+	return c, nil
+}
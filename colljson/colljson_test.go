@@ -0,0 +1,124 @@
+package colljson_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/colljson"
+	"github.com/FollowTheProcess/collections/counter"
+	"github.com/FollowTheProcess/collections/orderedmap"
+	"github.com/FollowTheProcess/collections/set"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestSet(t *testing.T) {
+	s := set.From([]string{"hello", "there", "general", "kenobi"})
+
+	data, err := colljson.MarshalSet(s)
+	test.Ok(t, err)
+
+	// Order is not guaranteed so check it's a valid array of the right items
+	var items []string
+	test.Ok(t, json.Unmarshal(data, &items))
+	test.EqualFunc(t, slices.Sorted(slices.Values(items)), []string{"general", "hello", "kenobi", "there"}, slices.Equal)
+
+	got, err := colljson.UnmarshalSet[string](data)
+	test.Ok(t, err)
+	test.True(t, set.Equal(got, s))
+
+	empty, err := colljson.MarshalSet[string](nil)
+	test.Ok(t, err)
+	test.Equal(t, string(empty), "[]")
+
+	_, err = colljson.UnmarshalSet[string]([]byte(`{"not": "an array"}`))
+	test.Err(t, err)
+}
+
+func TestOrderedMap(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	m.Insert("zebra", 1)
+	m.Insert("apple", 2)
+	m.Insert("mango", 3)
+
+	data, err := colljson.MarshalOrderedMap(m)
+	test.Ok(t, err)
+	test.Equal(t, string(data), `{"zebra":1,"apple":2,"mango":3}`) // Insertion order, not sorted
+
+	got, err := colljson.UnmarshalOrderedMap[string, int](data)
+	test.Ok(t, err)
+	test.EqualFunc(t, slices.Collect(got.Keys()), []string{"zebra", "apple", "mango"}, slices.Equal)
+	test.EqualFunc(t, slices.Collect(got.Values()), []int{1, 2, 3}, slices.Equal)
+
+	empty, err := colljson.MarshalOrderedMap(orderedmap.New[string, int]())
+	test.Ok(t, err)
+	test.Equal(t, string(empty), "{}")
+}
+
+func TestOrderedMapIntKeys(t *testing.T) {
+	m := orderedmap.New[int, []string]()
+	m.Insert(3, []string{"three"})
+	m.Insert(-1, []string{"minus", "one"})
+
+	data, err := colljson.MarshalOrderedMap(m)
+	test.Ok(t, err)
+	test.Equal(t, string(data), `{"3":["three"],"-1":["minus","one"]}`)
+
+	got, err := colljson.UnmarshalOrderedMap[int, []string](data)
+	test.Ok(t, err)
+	test.EqualFunc(t, slices.Collect(got.Keys()), []int{3, -1}, slices.Equal)
+}
+
+func TestOrderedMapErrors(t *testing.T) {
+	tests := []struct {
+		name string // Name of the test case
+		data string // The JSON to decode
+	}{
+		{name: "array", data: `[1, 2, 3]`},
+		{name: "bad value", data: `{"one": "not an int"}`},
+		{name: "bad key", data: `{"not an int": 1}`},
+		{name: "trailing", data: `{"1": 1} {}`},
+		{name: "truncated", data: `{"1": 1`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := colljson.UnmarshalOrderedMap[int, int]([]byte(tt.data))
+			test.Err(t, err)
+		})
+	}
+}
+
+func TestUnsupportedKey(t *testing.T) {
+	type point struct{ x, y int }
+
+	m := orderedmap.New[point, int]()
+	m.Insert(point{1, 2}, 1)
+
+	_, err := colljson.MarshalOrderedMap(m)
+	test.Err(t, err)
+}
+
+func TestCounter(t *testing.T) {
+	c := counter.From([]string{"apple", "apple", "apple", "orange", "banana", "banana"})
+
+	data, err := colljson.MarshalCounter(c)
+	test.Ok(t, err)
+	test.Equal(t, string(data), `{"apple":3,"banana":2,"orange":1}`) // Most common first
+
+	got, err := colljson.UnmarshalCounter[string](data)
+	test.Ok(t, err)
+	test.Equal(t, got.Get("apple"), 3)
+	test.Equal(t, got.Get("banana"), 2)
+	test.Equal(t, got.Get("orange"), 1)
+	test.Equal(t, got.Sum(), 6)
+
+	_, err = colljson.UnmarshalCounter[string]([]byte(`{"apple": -1}`))
+	test.Err(t, err)
+
+	// Huge counts must not be added one at a time
+	huge, err := colljson.UnmarshalCounter[string]([]byte(`{"apple": 1000000000000, "pear": 0}`))
+	test.Ok(t, err)
+	test.Equal(t, huge.Get("apple"), 1_000_000_000_000)
+	test.Equal(t, huge.Size(), 1) // Zero counts are skipped
+}
@@ -10,6 +10,9 @@ import (
 	"cmp"
 	"iter"
 	"slices"
+
+	"github.com/FollowTheProcess/collections/heap"
+	"github.com/FollowTheProcess/collections/tuple"
 )
 
 // Counter is a convenient construct for counting comparable values.
@@ -65,6 +68,13 @@ func (c *Counter[T]) Size() int {
 	return len(c.counts)
 }
 
+// Len returns the current number of unique items in the [Counter].
+//
+// It is equivalent to [Counter.Size], for consistency with the other containers.
+func (c *Counter[T]) Len() int {
+	return len(c.counts)
+}
+
 // Add adds an item to the counter, incrementing it's count and returning the new count.
 //
 // If the item doesn't exist, it is added to the counter with the count of 1, and 1 will be returned.
@@ -84,6 +94,20 @@ func (c *Counter[T]) Add(item T) int {
 	return v
 }
 
+// AddN adds n occurrences of an item to the counter in one go, returning the new count.
+//
+// It is equivalent to calling [Counter.Add] n times, but costs O(1) regardless of n. If
+// n is zero or negative, this is a no-op returning the item's current count.
+func (c *Counter[T]) AddN(item T, n int) int {
+	if n <= 0 {
+		return c.counts[item]
+	}
+
+	c.counts[item] += n
+
+	return c.counts[item]
+}
+
 // Sub subtracts an item from the counter, decrementing it's count and returning the new count.
 //
 // If the decrement would set the item's count to 0, it is then removed
@@ -200,6 +224,61 @@ func (c *Counter[T]) Descending() iter.Seq2[T, int] {
 	}
 }
 
+// NLargest returns the n items with the highest counts, paired with their counts, in
+// descending order of count.
+//
+// Rather than sorting every count like [Counter.Descending], it keeps a bounded heap of
+// size n during a single pass, so it is O(m log n) for a counter of m items. If the counter
+// has fewer than n items, all of them are returned. The order of items with equal counts is
+// non-deterministic.
+func (c *Counter[T]) NLargest(n int) []tuple.Pair[T, int] {
+	// The heap holds the best n seen so far with the smallest count on top, ready to be replaced
+	return c.nBest(n, func(a, b tuple.Pair[T, int]) bool { return a.Second < b.Second })
+}
+
+// NSmallest returns the n items with the lowest counts, paired with their counts, in
+// ascending order of count.
+//
+// Like [Counter.NLargest], it is O(m log n) for a counter of m items. If the counter
+// has fewer than n items, all of them are returned. The order of items with equal counts is
+// non-deterministic.
+func (c *Counter[T]) NSmallest(n int) []tuple.Pair[T, int] {
+	return c.nBest(n, func(a, b tuple.Pair[T, int]) bool { return a.Second > b.Second })
+}
+
+// nBest returns the n items that would be popped last from a heap ordered by worse,
+// in the reverse of that order, i.e. the best first.
+func (c *Counter[T]) nBest(n int, worse func(a, b tuple.Pair[T, int]) bool) []tuple.Pair[T, int] {
+	n = min(n, len(c.counts))
+	if n <= 0 {
+		return nil
+	}
+
+	best := heap.WithCapacity(n, worse)
+
+	for item, count := range c.counts {
+		pair := tuple.MakePair(item, count)
+		if best.Len() < n {
+			best.Push(pair)
+			continue
+		}
+
+		worst, _ := best.Peek() //nolint: errcheck // Only error is peek on empty heap
+		if worse(worst, pair) {
+			best.Pop() //nolint: errcheck // Only error is pop from empty heap
+			best.Push(pair)
+		}
+	}
+
+	// Popping yields the worst first, so fill the result from the back
+	result := make([]tuple.Pair[T, int], best.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i], _ = best.Pop() //nolint: errcheck // Only error is pop from empty heap
+	}
+
+	return result
+}
+
 // All returns an iterator over the item, count pairs in the Counter, yielding them
 // in a non-deterministic order.
 func (c *Counter[T]) All() iter.Seq2[T, int] {
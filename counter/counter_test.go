@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/FollowTheProcess/collections/counter"
+	"github.com/FollowTheProcess/collections/tuple"
 	"github.com/FollowTheProcess/test"
 )
 
@@ -39,6 +40,18 @@ func TestCount(t *testing.T) {
 	test.Equal(t, c.Get("cats"), 0)  // No cats in the counter
 }
 
+func TestAddN(t *testing.T) {
+	c := counter.New[string]()
+
+	test.Equal(t, c.AddN("human", 3), 3)  // New item starts at n
+	test.Equal(t, c.AddN("human", 2), 5)  // Existing item is incremented by n
+	test.Equal(t, c.AddN("dog", 0), 0)    // Zero is a no-op
+	test.Equal(t, c.AddN("human", -1), 5) // As is negative
+	test.Equal(t, c.Size(), 1)            // Dog should not have been added
+
+	test.Equal(t, c.AddN("cat", 1_000_000_000_000), 1_000_000_000_000) // Large counts are cheap
+}
+
 func TestFrom(t *testing.T) {
 	items := []int{1, 5, 2, 4, 8, 5, 4, 4, 6, 2, 3, 12}
 
@@ -215,6 +228,37 @@ func TestMostCommon(t *testing.T) {
 	})
 }
 
+func TestNLargest(t *testing.T) {
+	c := counter.From([]string{"a", "b", "b", "c", "c", "c", "d", "d", "d", "d"})
+
+	got := c.NLargest(2)
+	want := []tuple.Pair[string, int]{
+		tuple.MakePair("d", 4),
+		tuple.MakePair("c", 3),
+	}
+	test.EqualFunc(t, got, want, slices.Equal)
+
+	test.Equal(t, len(c.NLargest(10)), 4) // Fewer than n
+	test.Equal(t, len(c.NLargest(0)), 0)
+	test.Equal(t, len(counter.New[string]().NLargest(3)), 0)
+}
+
+func TestNSmallest(t *testing.T) {
+	c := counter.From([]string{"a", "b", "b", "c", "c", "c", "d", "d", "d", "d"})
+
+	got := c.NSmallest(3)
+	want := []tuple.Pair[string, int]{
+		tuple.MakePair("a", 1),
+		tuple.MakePair("b", 2),
+		tuple.MakePair("c", 3),
+	}
+	test.EqualFunc(t, got, want, slices.Equal)
+
+	all := c.NSmallest(10)
+	test.Equal(t, len(all), 4)
+	test.Equal(t, all[3], tuple.MakePair("d", 4))
+}
+
 func TestAll(t *testing.T) {
 	c := counter.New[string]()
 	c.Add("one")
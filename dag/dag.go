@@ -2,42 +2,18 @@ package dag
 
 import (
 	"errors"
-	"fmt"
 
+	"github.com/FollowTheProcess/collections/internal/adjacency"
 	"github.com/FollowTheProcess/collections/queue"
-	"github.com/FollowTheProcess/collections/set"
 )
 
-// vertex is a single node in the graph, and holds the underlying data
-// we want to represent in the graph.
-type vertex[T any] struct {
-	parents  *set.Set[*vertex[T]] // The direct parents of this vertex
-	children *set.Set[*vertex[T]] // The direct children of this vertex
-	item     T                    // The actual data
-}
-
-// newVertex creates and returns a new vertex containing item.
-func newVertex[T any](item T) *vertex[T] {
-	return &vertex[T]{
-		parents:  set.New[*vertex[T]](),
-		children: set.New[*vertex[T]](),
-		item:     item,
-	}
-}
-
-// inDegree returns the number of inbound edges to the vertex.
-func (v vertex[T]) inDegree() int {
-	return v.parents.Size()
-}
-
 // Graph is a generic directed acyclic graph, generic over 'K' which is a comparable
 // type to be used as the unique ID for each vertex and 'T' which is the data
 // you wish to store in each vertex of the graph.
 //
 // The ID must be unique within a [Graph].
 type Graph[K comparable, T any] struct {
-	vertices map[K]*vertex[T] // The map of id -> vertex
-	edges    int              // The current number of edges in the graph
+	inner adjacency.Graph[K, T] // The vertices and edges
 }
 
 // New creates and returns a new [Graph].
@@ -51,9 +27,7 @@ type Graph[K comparable, T any] struct {
 //
 // The ID must be unique within a [Graph].
 func New[K comparable, T any]() *Graph[K, T] {
-	return &Graph[K, T]{
-		vertices: make(map[K]*vertex[T]),
-	}
+	return &Graph[K, T]{inner: adjacency.New[K, T]()}
 }
 
 // WithCapacity creates and returns a new [Graph] with the given capacity.
@@ -61,19 +35,17 @@ func New[K comparable, T any]() *Graph[K, T] {
 // This can be a useful performance improvement if the expected maximum number of elements
 // the graph will hold is known ahead of time as it eliminates the need for reallocation.
 func WithCapacity[K comparable, T any](capacity int) *Graph[K, T] {
-	return &Graph[K, T]{
-		vertices: make(map[K]*vertex[T], capacity),
-	}
+	return &Graph[K, T]{inner: adjacency.WithCapacity[K, T](capacity)}
 }
 
 // Order returns the number of vertices in the graph.
 func (g *Graph[K, T]) Order() int {
-	return len(g.vertices)
+	return g.inner.Order()
 }
 
 // Size returns the number of edges in the graph.
 func (g *Graph[K, T]) Size() int {
-	return g.edges
+	return g.inner.Size()
 }
 
 // AddVertex adds an item to the graph as a vertex (or node) in the graph.
@@ -85,34 +57,19 @@ func (g *Graph[K, T]) Size() int {
 //
 // The ID must uniquely identify a single vertex in the [Graph].
 func (g *Graph[K, T]) AddVertex(id K, item T) error {
-	if _, exists := g.vertices[id]; exists {
-		return fmt.Errorf("vertex with id '%v' already exists", id)
-	}
-
-	g.vertices[id] = newVertex(item)
-
-	return nil
+	return g.inner.AddVertex(id, item)
 }
 
 // GetVertex returns the item stored in a vertex.
 //
 // If the vertex does not exist, an error will be returned.
 func (g *Graph[K, T]) GetVertex(id K) (T, error) {
-	var zero T
-
-	vertex, exists := g.vertices[id]
-	if !exists {
-		return zero, fmt.Errorf("vertex with id '%v' not in graph", id)
-	}
-
-	return vertex.item, nil
+	return g.inner.GetVertex(id)
 }
 
 // ContainsVertex reports whether a vertex with the given id is present in the graph.
 func (g *Graph[K, T]) ContainsVertex(id K) bool {
-	_, exists := g.vertices[id]
-
-	return exists
+	return g.inner.ContainsVertex(id)
 }
 
 // AddEdge creates a connection from the vertex with id 'from' and one
@@ -122,24 +79,18 @@ func (g *Graph[K, T]) ContainsVertex(id K) bool {
 // of task "two" depends on task "one" the signature would be:
 //
 //	AddEdge("one", "two")
+//
+// Adding an edge that already exists is a no-op.
 func (g *Graph[K, T]) AddEdge(from, to K) error {
-	parent, exists := g.vertices[from]
-	if !exists {
-		return fmt.Errorf("parent vertex with id '%v' not in graph", from)
-	}
-
-	child, exists := g.vertices[to]
-	if !exists {
-		return fmt.Errorf("child vertex with id '%v' not in graph", to)
-	}
-
-	// Create the connection
-	parent.children.Insert(child)
-	child.parents.Insert(parent)
-
-	g.edges++
+	return g.inner.AddEdge(from, to)
+}
 
-	return nil
+// IsAcyclic reports whether the graph contains no cycles.
+//
+// Edges are not checked for cycles as they are added, so this is a cheap way of
+// validating a graph built up incrementally without sorting it.
+func (g *Graph[K, T]) IsAcyclic() bool {
+	return g.inner.IsAcyclic()
 }
 
 // Sort returns the topological sort of the graph, returning the underlying items
@@ -147,43 +98,47 @@ func (g *Graph[K, T]) AddEdge(from, to K) error {
 //
 // A DAG may have multiple valid topological sorts, the one returned from this function
 // is guaranteed to be valid but is not deterministic.
+//
+// Sort does not modify the graph, so it may be called more than once.
 func (g *Graph[K, T]) Sort() ([]T, error) {
 	// Note: this is kahns algorithm
 	// https://en.wikipedia.org/wiki/Topological_sorting
-	zeroInDegreeQueue := queue.New[*vertex[T]]()
-	result := make([]T, 0, len(g.vertices))
-
-	for _, vertex := range g.vertices {
-		// Put all vertices with a 0 in-degree into the queue
-		if vertex.inDegree() == 0 {
-			zeroInDegreeQueue.Push(vertex)
-		}
-	}
-
-	// If there is not at least 1 vertex with 0 in-degree, then it's not
-	// a DAG and cannot be sorted
-	if zeroInDegreeQueue.IsEmpty() {
-		return nil, errors.New("graph contains a cycle and cannot be sorted")
+	//
+	// It works on a copy of the in-degrees rather than removing edges, so the
+	// graph is left intact and may be sorted again
+	inDegree, roots := g.inner.InDegrees()
+	zeroInDegreeQueue := queue.New[K]()
+	result := make([]T, 0, g.inner.Order())
+
+	// Put all vertices with a 0 in-degree into the queue
+	for _, id := range roots {
+		zeroInDegreeQueue.Push(id)
 	}
 
 	// While queue is not empty
 	for !zeroInDegreeQueue.IsEmpty() {
-		vert, _ := zeroInDegreeQueue.Pop() //nolint: errcheck // Only error is pop from empty queue
+		id, _ := zeroInDegreeQueue.Pop() //nolint: errcheck // Only error is pop from empty queue
+		vertex := g.inner.Vertices[id]
 
 		// Add its item to the result slice
-		result = append(result, vert.item)
+		result = append(result, vertex.Item)
 
-		// For each child, remove 'vert' as a parent and check if it
+		// For each child, discount 'vertex' as a parent and check if it
 		// now has an in-degree of 0
-		for child := range vert.children.All() {
-			child.parents.Remove(vert)
+		for child := range vertex.Children.All() {
+			inDegree[child]--
 
 			// If it now has an in-degree of 0, add it to the queue
-			if child.inDegree() == 0 {
+			if inDegree[child] == 0 {
 				zeroInDegreeQueue.Push(child)
 			}
 		}
 	}
 
+	// Any vertex never reaching an in-degree of 0 is on (or downstream of) a cycle
+	if len(result) != g.inner.Order() {
+		return nil, errors.New("graph contains a cycle and cannot be sorted")
+	}
+
 	return result, nil
 }
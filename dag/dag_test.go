@@ -170,6 +170,58 @@ func TestSort(t *testing.T) {
 		test.Err(t, err)
 		test.Equal(t, err.Error(), "graph contains a cycle and cannot be sorted")
 	})
+
+	t.Run("cycle downstream of a root", func(t *testing.T) {
+		graph := dag.New[string, int]()
+
+		test.Ok(t, graph.AddVertex("root", 0))
+		test.Ok(t, graph.AddVertex("one", 1))
+		test.Ok(t, graph.AddVertex("two", 2))
+
+		// root has an in-degree of 0, but one and two form a cycle beneath it
+		test.Ok(t, graph.AddEdge("root", "one"))
+		test.Ok(t, graph.AddEdge("one", "two"))
+		test.Ok(t, graph.AddEdge("two", "one"))
+
+		_, err := graph.Sort()
+		test.Err(t, err)
+	})
+
+	t.Run("repeatable", func(t *testing.T) {
+		graph := makeGraph(t)
+
+		first, err := graph.Sort()
+		test.Ok(t, err)
+
+		second, err := graph.Sort()
+		test.Ok(t, err)
+
+		test.Equal(t, len(first), len(second)) // Sort must leave the graph intact
+		test.Equal(t, graph.Size(), 2)
+	})
+}
+
+func TestIsAcyclic(t *testing.T) {
+	graph := makeGraph(t)
+	test.True(t, graph.IsAcyclic())
+
+	// IsAcyclic must not modify the graph, so it should still sort afterwards
+	_, err := graph.Sort()
+	test.Ok(t, err)
+
+	cyclic := dag.New[string, int]()
+	test.Ok(t, cyclic.AddVertex("one", 1))
+	test.Ok(t, cyclic.AddVertex("two", 2))
+	test.Ok(t, cyclic.AddVertex("three", 3))
+	test.Ok(t, cyclic.AddEdge("one", "two"))
+	test.Ok(t, cyclic.AddEdge("two", "three"))
+	test.True(t, cyclic.IsAcyclic())
+
+	test.Ok(t, cyclic.AddEdge("three", "one"))
+	test.False(t, cyclic.IsAcyclic())
+	test.Equal(t, cyclic.Size(), 3) // Still unchanged
+
+	test.True(t, dag.New[string, int]().IsAcyclic())
 }
 
 func isInPossibleSolutions[T comparable](result []T, possibles [][]T) bool {
@@ -206,14 +258,11 @@ func makeGraph(tb testing.TB) *dag.Graph[string, int] {
 }
 
 func BenchmarkGraphSort(b *testing.B) {
-	// Because the graph.Sort method alters the state of the graph (removing edges)
-	// a new graph must be constructed for each run meaning this is actually quite slow to run (~1 minute)
-	// but we stop and start the timer at the right places to ensure just the sorting code's performance is measured
-	for range b.N {
-		b.StopTimer()
-		graph := makeGraph(b)
-		b.StartTimer()
+	graph := makeGraph(b)
+
+	b.ResetTimer()
 
+	for range b.N {
 		_, err := graph.Sort()
 		if err != nil {
 			b.Fatalf("graph.Sort returned an error: %v", err)
@@ -0,0 +1,226 @@
+// Package deque implements a double-ended queue generic over any type.
+//
+// The deque is backed by a ring buffer, offering O(1) (amortised) insertion and removal
+// at both the front and the back.
+//
+// The deque is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package deque
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// minCapacity is the capacity allocated the first time an item is pushed
+// into a deque with no capacity.
+const minCapacity = 4
+
+// Deque is a double-ended queue generic over any type.
+type Deque[T any] struct {
+	container []T // Underlying ring buffer, len(container) is the capacity of the deque
+	head      int // Index in container of the item at the front of the deque
+	size      int // The number of items currently in the deque
+}
+
+// New constructs and returns a new [Deque].
+func New[T any]() *Deque[T] {
+	return &Deque[T]{container: make([]T, 0)}
+}
+
+// WithCapacity constructs and returns a new [Deque] with the given capacity.
+//
+// This can be a useful performance improvement when the expected maximum size of the deque is
+// known ahead of time as it eliminates the need for reallocation.
+func WithCapacity[T any](capacity int) *Deque[T] {
+	return &Deque[T]{container: make([]T, capacity)}
+}
+
+// From builds a [Deque] from an existing slice of items, pushing items
+// onto the back of the deque in the order of the slice.
+//
+// The deque will be preallocated the size of len(items).
+func From[T any](items []T) *Deque[T] {
+	deque := WithCapacity[T](len(items))
+	copy(deque.container, items)
+	deque.size = len(items)
+
+	return deque
+}
+
+// Collect builds a [Deque] from an iterator of items, pushing items
+// onto the back of the deque in the order of iteration.
+func Collect[T any](items iter.Seq[T]) *Deque[T] {
+	deque := New[T]()
+	for item := range items {
+		deque.PushBack(item)
+	}
+
+	return deque
+}
+
+// PushFront adds an item to the front of the deque.
+//
+//	d := deque.New[string]()
+//	d.PushFront("hello")
+func (d *Deque[T]) PushFront(item T) {
+	d.growIfFull()
+	d.head = d.index(len(d.container) - 1) // One step back, wrapping if necessary
+	d.container[d.head] = item
+	d.size++
+}
+
+// PushBack adds an item to the back of the deque.
+//
+//	d := deque.New[string]()
+//	d.PushBack("hello")
+func (d *Deque[T]) PushBack(item T) {
+	d.growIfFull()
+	d.container[d.index(d.size)] = item
+	d.size++
+}
+
+// PopFront removes and returns the item at the front of the deque, if the
+// deque is empty, an error will be returned.
+//
+//	d := deque.New[string]()
+//	d.PushBack("hello")
+//	d.PushBack("there")
+//	item, _ := d.PopFront()
+//	fmt.Println(item) // "hello"
+func (d *Deque[T]) PopFront() (T, error) {
+	var none T
+	if d.size == 0 {
+		return none, errors.New("pop from empty deque")
+	}
+
+	item := d.container[d.head]
+	d.container[d.head] = none // Don't hold on to the item so it may be garbage collected
+	d.head = d.index(1)
+	d.size--
+
+	return item, nil
+}
+
+// PopBack removes and returns the item at the back of the deque, if the
+// deque is empty, an error will be returned.
+//
+//	d := deque.New[string]()
+//	d.PushBack("hello")
+//	d.PushBack("there")
+//	item, _ := d.PopBack()
+//	fmt.Println(item) // "there"
+func (d *Deque[T]) PopBack() (T, error) {
+	var none T
+	if d.size == 0 {
+		return none, errors.New("pop from empty deque")
+	}
+
+	index := d.index(d.size - 1)
+	item := d.container[index]
+	d.container[index] = none // Don't hold on to the item so it may be garbage collected
+	d.size--
+
+	return item, nil
+}
+
+// Front returns the item at the front of the deque without removing it, if
+// the deque is empty, an error will be returned.
+func (d *Deque[T]) Front() (T, error) {
+	if d.size == 0 {
+		var none T
+
+		return none, errors.New("Front() called on empty deque")
+	}
+
+	return d.container[d.head], nil
+}
+
+// Back returns the item at the back of the deque without removing it, if
+// the deque is empty, an error will be returned.
+func (d *Deque[T]) Back() (T, error) {
+	if d.size == 0 {
+		var none T
+
+		return none, errors.New("Back() called on empty deque")
+	}
+
+	return d.container[d.index(d.size-1)], nil
+}
+
+// Len returns the number of items in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// Capacity returns the capacity of the deque, i.e. the number of items
+// it can contain without the need for reallocation.
+func (d *Deque[T]) Capacity() int {
+	return len(d.container)
+}
+
+// IsEmpty returns whether or not the deque is empty.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// All returns an iterator over the items in the deque, from front to back.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.size {
+			if !yield(d.container[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backwards returns an iterator over the items in the deque, from back to front.
+func (d *Deque[T]) Backwards() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.container[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// String satisfies the [fmt.Stringer] interface and allows a Deque to be printed.
+func (d *Deque[T]) String() string {
+	items := make([]T, d.size)
+	d.copyTo(items)
+
+	return fmt.Sprintf("%v", items)
+}
+
+// index returns the position in the underlying container of the
+// item i places from the front of the deque.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.container)
+}
+
+// copyTo copies the items in the deque into dst from front to back, dst must have
+// a length of at least d.size.
+func (d *Deque[T]) copyTo(dst []T) {
+	if d.size == 0 {
+		return
+	}
+
+	// The items may wrap around the end of the container, in which case
+	// there are two contiguous chunks to copy
+	n := copy(dst, d.container[d.head:min(d.head+d.size, len(d.container))])
+	copy(dst[n:d.size], d.container)
+}
+
+// growIfFull doubles the capacity of the deque if there is no room
+// for another item.
+func (d *Deque[T]) growIfFull() {
+	if d.size == len(d.container) {
+		container := make([]T, max(2*len(d.container), minCapacity)) //nolint: mnd // Doubling, 2 is not magic
+		d.copyTo(container)
+		d.container = container
+		d.head = 0
+	}
+}
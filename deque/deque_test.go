@@ -0,0 +1,150 @@
+package deque_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/deque"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestIsEmpty(t *testing.T) {
+	d := deque.New[string]()
+	test.True(t, d.IsEmpty())
+
+	d.PushBack("hello")
+	d.PushFront("there")
+
+	test.False(t, d.IsEmpty())
+	test.Equal(t, d.Len(), 2)
+}
+
+func TestCapacity(t *testing.T) {
+	d := deque.WithCapacity[int](10)
+	test.Equal(t, d.Capacity(), 10)
+}
+
+func TestPushPop(t *testing.T) {
+	d := deque.New[string]()
+	d.PushBack("general")
+	d.PushFront("there")
+	d.PushBack("kenobi")
+	d.PushFront("hello")
+
+	test.Equal(t, d.Len(), 4)
+
+	item, err := d.PopFront()
+	test.Ok(t, err)
+	test.Equal(t, item, "hello")
+
+	item, err = d.PopBack()
+	test.Ok(t, err)
+	test.Equal(t, item, "kenobi")
+
+	item, err = d.PopBack()
+	test.Ok(t, err)
+	test.Equal(t, item, "general")
+
+	item, err = d.PopFront()
+	test.Ok(t, err)
+	test.Equal(t, item, "there")
+
+	// Both should error now
+	_, err = d.PopFront()
+	test.Err(t, err)
+
+	_, err = d.PopBack()
+	test.Err(t, err)
+}
+
+func TestFrontBack(t *testing.T) {
+	d := deque.New[string]()
+
+	_, err := d.Front()
+	test.Err(t, err)
+
+	_, err = d.Back()
+	test.Err(t, err)
+
+	d.PushBack("hello")
+	d.PushBack("there")
+
+	front, err := d.Front()
+	test.Ok(t, err)
+	test.Equal(t, front, "hello")
+
+	back, err := d.Back()
+	test.Ok(t, err)
+	test.Equal(t, back, "there")
+
+	test.Equal(t, d.Len(), 2) // Front and Back should not remove anything
+}
+
+func TestWrapAround(t *testing.T) {
+	d := deque.WithCapacity[int](4)
+
+	// Push on the front so the head wraps round the end of the buffer
+	for i := range 3 {
+		d.PushFront(i)
+	}
+
+	d.PushBack(100)
+	test.Equal(t, d.Capacity(), 4)
+
+	// Now force a resize while wrapped
+	d.PushBack(101)
+
+	test.EqualFunc(t, slices.Collect(d.All()), []int{2, 1, 0, 100, 101}, slices.Equal)
+	test.Equal(t, d.String(), "[2 1 0 100 101]")
+}
+
+func TestAll(t *testing.T) {
+	d := deque.From([]string{"hello", "there", "general", "kenobi"})
+
+	test.EqualFunc(t, slices.Collect(d.All()), []string{"hello", "there", "general", "kenobi"}, slices.Equal)
+	test.EqualFunc(
+		t,
+		slices.Collect(d.Backwards()),
+		[]string{"kenobi", "general", "there", "hello"},
+		slices.Equal,
+	)
+}
+
+func TestCollect(t *testing.T) {
+	items := []string{"cheese", "apples", "wine", "beer"}
+
+	d := deque.Collect(slices.Values(items))
+
+	test.Equal(t, d.Len(), 4)
+
+	first, err := d.PopFront()
+	test.Ok(t, err)
+	test.Equal(t, first, "cheese")
+
+	last, err := d.PopBack()
+	test.Ok(t, err)
+	test.Equal(t, last, "beer")
+}
+
+func TestNotNew(t *testing.T) {
+	d := deque.Deque[int]{}
+	d.PushBack(1)
+	d.PushFront(2)
+
+	first, err := d.PopFront()
+	test.Ok(t, err)
+	test.Equal(t, first, 2)
+}
+
+func BenchmarkDeque(b *testing.B) {
+	d := deque.New[int]()
+
+	for range b.N {
+		d.PushFront(1)
+
+		_, err := d.PopBack()
+		if err != nil {
+			b.Errorf("PopBack() returned an error: %v", err)
+		}
+	}
+}
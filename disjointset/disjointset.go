@@ -0,0 +1,145 @@
+// Package disjointset implements a generic disjoint-set (or union-find) data structure.
+//
+// A disjoint-set tracks a collection of items partitioned into non-overlapping sets, offering
+// near constant time merging of sets and checks of whether two items belong to the same set. It
+// is the backbone of many graph algorithms such as finding connected components or Kruskal's
+// minimum spanning tree.
+//
+// The disjoint-set is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package disjointset
+
+// Set is a generic disjoint-set, using path compression and union by rank
+// internally.
+type Set[T comparable] struct {
+	parent map[T]T   // Map of item -> parent item, the root of a set is it's own parent
+	rank   map[T]int // Upper bound on the height of the tree rooted at each item
+	count  int       // The number of disjoint sets
+}
+
+// New builds and returns a new, empty disjoint [Set].
+func New[T comparable]() *Set[T] {
+	return &Set[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+	}
+}
+
+// WithCapacity builds and returns a new disjoint [Set] with the given capacity.
+//
+// This can be a useful performance improvement when the expected number of items
+// is known ahead of time as it eliminates the need for reallocation.
+func WithCapacity[T comparable](capacity int) *Set[T] {
+	return &Set[T]{
+		parent: make(map[T]T, capacity),
+		rank:   make(map[T]int, capacity),
+	}
+}
+
+// MakeSet adds item as a new singleton set, reporting whether it was
+// newly added. Adding an item that is already present is a no-op.
+//
+//	s := disjointset.New[string]()
+//	s.MakeSet("a") // true
+//	s.MakeSet("a") // false
+func (s *Set[T]) MakeSet(item T) bool {
+	if _, exists := s.parent[item]; exists {
+		return false
+	}
+
+	// nil safety
+	if s.parent == nil {
+		s.parent = make(map[T]T)
+		s.rank = make(map[T]int)
+	}
+
+	s.parent[item] = item
+	s.rank[item] = 0
+	s.count++
+
+	return true
+}
+
+// Contains reports whether item has been added to the disjoint set.
+func (s *Set[T]) Contains(item T) bool {
+	_, exists := s.parent[item]
+
+	return exists
+}
+
+// Find returns the representative item of the set containing item, two
+// items are in the same set if and only if they share a representative.
+//
+// If item has not yet been seen, it is first added as a new singleton set, and
+// is therefore its own representative.
+func (s *Set[T]) Find(item T) T {
+	s.MakeSet(item)
+
+	root := item
+	for s.parent[root] != root {
+		root = s.parent[root]
+	}
+
+	// Path compression: point everything on the way up directly at the root
+	for item != root {
+		next := s.parent[item]
+		s.parent[item] = root
+		item = next
+	}
+
+	return root
+}
+
+// Union merges the sets containing a and b, reporting whether they were
+// previously disjoint (and therefore whether anything changed).
+//
+// Any item not yet seen is first added as a new singleton set.
+//
+//	s := disjointset.New[string]()
+//	s.Union("a", "b") // true
+//	s.Union("b", "a") // false, already in the same set
+func (s *Set[T]) Union(a, b T) bool {
+	rootA := s.Find(a)
+	rootB := s.Find(b)
+
+	if rootA == rootB {
+		return false
+	}
+
+	// Union by rank: attach the shorter tree beneath the taller one
+	switch rankA, rankB := s.rank[rootA], s.rank[rootB]; {
+	case rankA < rankB:
+		s.parent[rootA] = rootB
+	case rankA > rankB:
+		s.parent[rootB] = rootA
+	default:
+		s.parent[rootB] = rootA
+		s.rank[rootA]++
+	}
+
+	s.count--
+
+	return true
+}
+
+// Connected reports whether a and b belong to the same set.
+//
+// Unlike [Set.Union], Connected never adds items, if either item has not yet
+// been seen it belongs to no set and Connected returns false.
+func (s *Set[T]) Connected(a, b T) bool {
+	if !s.Contains(a) || !s.Contains(b) {
+		return false
+	}
+
+	return s.Find(a) == s.Find(b)
+}
+
+// Count returns the number of disjoint sets.
+func (s *Set[T]) Count() int {
+	return s.count
+}
+
+// Size returns the total number of items across all the sets.
+func (s *Set[T]) Size() int {
+	return len(s.parent)
+}
@@ -0,0 +1,87 @@
+package disjointset_test
+
+import (
+	"testing"
+
+	"github.com/FollowTheProcess/collections/disjointset"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestMakeSet(t *testing.T) {
+	s := disjointset.New[string]()
+	test.Equal(t, s.Count(), 0)
+	test.Equal(t, s.Size(), 0)
+
+	test.True(t, s.MakeSet("a"))
+	test.False(t, s.MakeSet("a")) // Already exists
+	test.True(t, s.MakeSet("b"))
+
+	test.True(t, s.Contains("a"))
+	test.False(t, s.Contains("c"))
+
+	test.Equal(t, s.Count(), 2)
+	test.Equal(t, s.Size(), 2)
+
+	test.Equal(t, s.Find("a"), "a") // Singletons are their own representative
+}
+
+func TestUnion(t *testing.T) {
+	s := disjointset.New[int]()
+
+	for i := range 10 {
+		s.MakeSet(i)
+	}
+
+	test.Equal(t, s.Count(), 10)
+
+	// Evens and odds
+	for i := 2; i < 10; i++ {
+		test.True(t, s.Union(i, i-2))
+	}
+
+	test.Equal(t, s.Count(), 2)
+	test.False(t, s.Union(0, 8)) // Already connected
+
+	test.True(t, s.Connected(0, 8))
+	test.True(t, s.Connected(1, 9))
+	test.False(t, s.Connected(0, 1))
+
+	test.Equal(t, s.Find(2), s.Find(6))
+
+	test.True(t, s.Union(3, 4))
+	test.Equal(t, s.Count(), 1)
+	test.True(t, s.Connected(0, 9))
+}
+
+func TestImplicitMakeSet(t *testing.T) {
+	s := disjointset.New[string]()
+
+	test.False(t, s.Connected("a", "b")) // Both unseen, Connected doesn't add them
+	test.False(t, s.Connected("a", "a")) // Not in any set, so not even connected to itself
+	test.Equal(t, s.Count(), 0)
+	test.Equal(t, s.Size(), 0)
+
+	test.True(t, s.Union("b", "c")) // But Union does
+	test.Equal(t, s.Count(), 1)
+	test.Equal(t, s.Size(), 2)
+
+	test.False(t, s.Connected("b", "d"))
+	test.Equal(t, s.Size(), 2)
+}
+
+func TestNotNew(t *testing.T) {
+	s := disjointset.Set[string]{}
+	test.True(t, s.Union("a", "b"))
+	test.True(t, s.Connected("a", "b"))
+	test.Equal(t, s.Count(), 1)
+}
+
+func BenchmarkUnion(b *testing.B) {
+	s := disjointset.WithCapacity[int](b.N)
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		s.Union(i, i/2)
+	}
+}
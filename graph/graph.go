@@ -0,0 +1,198 @@
+// Package graph implements a generic directed graph which, unlike a [dag.Graph], may
+// contain cycles.
+//
+// The graph is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package graph
+
+import (
+	"iter"
+
+	"github.com/FollowTheProcess/collections/internal/adjacency"
+	"github.com/FollowTheProcess/collections/set"
+)
+
+// Graph is a generic directed graph, generic over 'K' which is a comparable
+// type to be used as the unique ID for each vertex and 'T' which is the data
+// you wish to store in each vertex of the graph.
+//
+// The ID must be unique within a [Graph].
+type Graph[K comparable, T any] struct {
+	inner adjacency.Graph[K, T] // The vertices and edges
+}
+
+// New creates and returns a new [Graph].
+//
+// It is generic over 'K' which is a comparable type to be used as the unique ID
+// for each vertex, and 'T' which is the data you wish to store in each vertex of the graph.
+//
+// So for a graph storing integers with a unique ID that is a string, the signature would be:
+//
+//	g := graph.New[string, int]()
+func New[K comparable, T any]() *Graph[K, T] {
+	return &Graph[K, T]{inner: adjacency.New[K, T]()}
+}
+
+// WithCapacity creates and returns a new [Graph] with the given capacity.
+//
+// This can be a useful performance improvement if the expected maximum number of elements
+// the graph will hold is known ahead of time as it eliminates the need for reallocation.
+func WithCapacity[K comparable, T any](capacity int) *Graph[K, T] {
+	return &Graph[K, T]{inner: adjacency.WithCapacity[K, T](capacity)}
+}
+
+// Order returns the number of vertices in the graph.
+func (g *Graph[K, T]) Order() int {
+	return g.inner.Order()
+}
+
+// Size returns the number of edges in the graph.
+func (g *Graph[K, T]) Size() int {
+	return g.inner.Size()
+}
+
+// AddVertex adds an item to the graph as a vertex (or node) in the graph.
+//
+// If the vertex already exists, an error will be returned.
+func (g *Graph[K, T]) AddVertex(id K, item T) error {
+	return g.inner.AddVertex(id, item)
+}
+
+// GetVertex returns the item stored in a vertex.
+//
+// If the vertex does not exist, an error will be returned.
+func (g *Graph[K, T]) GetVertex(id K) (T, error) {
+	return g.inner.GetVertex(id)
+}
+
+// ContainsVertex reports whether a vertex with the given id is present in the graph.
+func (g *Graph[K, T]) ContainsVertex(id K) bool {
+	return g.inner.ContainsVertex(id)
+}
+
+// RemoveVertex removes a vertex and all of its edges from the graph.
+//
+// If the vertex does not exist, an error will be returned.
+func (g *Graph[K, T]) RemoveVertex(id K) error {
+	return g.inner.RemoveVertex(id)
+}
+
+// AddEdge creates a directed connection from the vertex with id 'from' to the
+// one with id 'to'. An edge from a vertex to itself is allowed.
+//
+// If either vertex does not exist, an error will be returned. Adding an edge that
+// already exists is a no-op.
+func (g *Graph[K, T]) AddEdge(from, to K) error {
+	return g.inner.AddEdge(from, to)
+}
+
+// RemoveEdge removes the directed connection from the vertex with id 'from'
+// to the one with id 'to'.
+//
+// If either vertex, or the edge itself, does not exist, an error will be returned.
+func (g *Graph[K, T]) RemoveEdge(from, to K) error {
+	return g.inner.RemoveEdge(from, to)
+}
+
+// ContainsEdge reports whether there is a directed connection from the vertex
+// with id 'from' to the one with id 'to'.
+func (g *Graph[K, T]) ContainsEdge(from, to K) bool {
+	return g.inner.ContainsEdge(from, to)
+}
+
+// Successors returns an iterator over the ids of the vertices that the vertex with
+// the given id has an edge to.
+//
+// If the vertex does not exist, an error will be returned. The order of the ids
+// is non-deterministic.
+func (g *Graph[K, T]) Successors(id K) (iter.Seq[K], error) {
+	return g.inner.Successors(id)
+}
+
+// Predecessors returns an iterator over the ids of the vertices that have an edge
+// to the vertex with the given id.
+//
+// If the vertex does not exist, an error will be returned. The order of the ids
+// is non-deterministic.
+func (g *Graph[K, T]) Predecessors(id K) (iter.Seq[K], error) {
+	return g.inner.Predecessors(id)
+}
+
+// IsAcyclic reports whether the graph contains no cycles.
+func (g *Graph[K, T]) IsAcyclic() bool {
+	return g.inner.IsAcyclic()
+}
+
+// StronglyConnectedComponents returns the strongly connected components of the graph, that
+// is; the maximal groups of vertices where every vertex in the group can reach every other.
+//
+// Each component is returned as a slice of vertex ids. A vertex not on any cycle forms a
+// component on its own. Components are returned in reverse topological order, so for any
+// edge between two components, the component it points to comes first.
+func (g *Graph[K, T]) StronglyConnectedComponents() [][]K {
+	// Note: this is Tarjan's algorithm
+	// https://en.wikipedia.org/wiki/Tarjan%27s_strongly_connected_components_algorithm
+	t := tarjan[K, T]{
+		graph:   g,
+		index:   make(map[K]int, g.inner.Order()),
+		lowlink: make(map[K]int, g.inner.Order()),
+		onStack: set.WithCapacity[K](g.inner.Order()),
+	}
+
+	for id := range g.inner.Vertices {
+		if _, visited := t.index[id]; !visited {
+			t.strongConnect(id)
+		}
+	}
+
+	return t.components
+}
+
+// tarjan holds the state of Tarjan's strongly connected components algorithm.
+type tarjan[K comparable, T any] struct {
+	graph      *Graph[K, T] // The graph being searched
+	index      map[K]int    // The order in which each vertex was discovered
+	lowlink    map[K]int    // The smallest index reachable from each vertex
+	onStack    *set.Set[K]  // The vertices currently on the stack
+	stack      []K          // The stack of vertices in the current search
+	components [][]K        // The components found so far
+	counter    int          // The next index to assign
+}
+
+// strongConnect does a depth first search from id, recording any components found.
+func (t *tarjan[K, T]) strongConnect(id K) {
+	t.index[id] = t.counter
+	t.lowlink[id] = t.counter
+	t.counter++
+
+	t.stack = append(t.stack, id)
+	t.onStack.Insert(id)
+
+	for child := range t.graph.inner.Vertices[id].Children.All() {
+		if _, visited := t.index[child]; !visited {
+			t.strongConnect(child)
+			t.lowlink[id] = min(t.lowlink[id], t.lowlink[child])
+		} else if t.onStack.Contains(child) {
+			t.lowlink[id] = min(t.lowlink[id], t.index[child])
+		}
+	}
+
+	// If id is the root of a component, pop the whole component off the stack
+	if t.lowlink[id] == t.index[id] {
+		var component []K
+
+		for {
+			top := t.stack[len(t.stack)-1]
+			t.stack = t.stack[:len(t.stack)-1]
+			t.onStack.Remove(top)
+
+			component = append(component, top)
+
+			if top == id {
+				break
+			}
+		}
+
+		t.components = append(t.components, component)
+	}
+}
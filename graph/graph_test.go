@@ -0,0 +1,146 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/graph"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestVertices(t *testing.T) {
+	g := graph.New[string, int]()
+	test.Equal(t, g.Order(), 0)
+
+	test.Ok(t, g.AddVertex("one", 1))
+	test.Ok(t, g.AddVertex("two", 2))
+	test.Err(t, g.AddVertex("one", 1)) // Already exists
+
+	test.Equal(t, g.Order(), 2)
+	test.True(t, g.ContainsVertex("one"))
+	test.False(t, g.ContainsVertex("three"))
+
+	one, err := g.GetVertex("one")
+	test.Ok(t, err)
+	test.Equal(t, one, 1)
+
+	_, err = g.GetVertex("three")
+	test.Err(t, err)
+}
+
+func TestEdges(t *testing.T) {
+	g := graph.New[string, int]()
+	test.Ok(t, g.AddVertex("one", 1))
+	test.Ok(t, g.AddVertex("two", 2))
+	test.Ok(t, g.AddVertex("three", 3))
+
+	test.Err(t, g.AddEdge("one", "missing"))
+	test.Err(t, g.AddEdge("missing", "one"))
+
+	test.Ok(t, g.AddEdge("one", "two"))
+	test.Ok(t, g.AddEdge("one", "two")) // Duplicate, no-op
+	test.Ok(t, g.AddEdge("two", "one")) // Cycles are fine
+	test.Ok(t, g.AddEdge("one", "three"))
+	test.Ok(t, g.AddEdge("three", "three")) // As are self loops
+
+	test.Equal(t, g.Size(), 4)
+	test.True(t, g.ContainsEdge("one", "two"))
+	test.False(t, g.ContainsEdge("two", "three"))
+	test.False(t, g.ContainsEdge("missing", "two"))
+
+	successors, err := g.Successors("one")
+	test.Ok(t, err)
+	test.EqualFunc(t, slices.Sorted(successors), []string{"three", "two"}, slices.Equal)
+
+	predecessors, err := g.Predecessors("one")
+	test.Ok(t, err)
+	test.EqualFunc(t, slices.Sorted(predecessors), []string{"two"}, slices.Equal)
+
+	_, err = g.Successors("missing")
+	test.Err(t, err)
+
+	_, err = g.Predecessors("missing")
+	test.Err(t, err)
+
+	test.Ok(t, g.RemoveEdge("one", "two"))
+	test.Err(t, g.RemoveEdge("one", "two")) // Already gone
+	test.Err(t, g.RemoveEdge("missing", "two"))
+	test.Err(t, g.RemoveEdge("one", "missing"))
+	test.Equal(t, g.Size(), 3)
+	test.False(t, g.ContainsEdge("one", "two"))
+
+	predecessors, err = g.Predecessors("two")
+	test.Ok(t, err)
+	test.Equal(t, len(slices.Collect(predecessors)), 0)
+}
+
+func TestRemoveVertex(t *testing.T) {
+	g := graph.New[string, int]()
+	test.Ok(t, g.AddVertex("one", 1))
+	test.Ok(t, g.AddVertex("two", 2))
+	test.Ok(t, g.AddVertex("three", 3))
+
+	test.Ok(t, g.AddEdge("one", "two"))
+	test.Ok(t, g.AddEdge("two", "three"))
+	test.Ok(t, g.AddEdge("three", "two"))
+	test.Ok(t, g.AddEdge("two", "two"))
+
+	test.Err(t, g.RemoveVertex("missing"))
+
+	test.Ok(t, g.RemoveVertex("two"))
+	test.Equal(t, g.Order(), 2)
+	test.Equal(t, g.Size(), 0)
+
+	successors, err := g.Successors("one")
+	test.Ok(t, err)
+	test.Equal(t, len(slices.Collect(successors)), 0)
+}
+
+func TestIsAcyclic(t *testing.T) {
+	g := graph.New[int, int]()
+	test.True(t, g.IsAcyclic()) // Empty graph
+
+	for i := range 4 {
+		test.Ok(t, g.AddVertex(i, i))
+	}
+
+	test.Ok(t, g.AddEdge(0, 1))
+	test.Ok(t, g.AddEdge(1, 2))
+	test.Ok(t, g.AddEdge(0, 3))
+	test.True(t, g.IsAcyclic())
+
+	test.Ok(t, g.AddEdge(2, 0))
+	test.False(t, g.IsAcyclic())
+
+	test.Ok(t, g.RemoveEdge(2, 0))
+	test.Ok(t, g.AddEdge(3, 3))
+	test.False(t, g.IsAcyclic()) // Self loop is a cycle
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := graph.New[int, int]()
+	for i := range 6 {
+		test.Ok(t, g.AddVertex(i, i))
+	}
+
+	// 0 -> 1 -> 2 -> 0 is one component, 3 <-> 4 is another, and 5 is on its own
+	test.Ok(t, g.AddEdge(0, 1))
+	test.Ok(t, g.AddEdge(1, 2))
+	test.Ok(t, g.AddEdge(2, 0))
+	test.Ok(t, g.AddEdge(2, 3))
+	test.Ok(t, g.AddEdge(3, 4))
+	test.Ok(t, g.AddEdge(4, 3))
+	test.Ok(t, g.AddEdge(4, 5))
+
+	components := g.StronglyConnectedComponents()
+	test.Equal(t, len(components), 3)
+
+	for _, component := range components {
+		slices.Sort(component)
+	}
+
+	// Reverse topological order
+	test.EqualFunc(t, components[0], []int{5}, slices.Equal)
+	test.EqualFunc(t, components[1], []int{3, 4}, slices.Equal)
+	test.EqualFunc(t, components[2], []int{0, 1, 2}, slices.Equal)
+}
@@ -0,0 +1,175 @@
+// Package heap implements a generic binary heap ordered by a user supplied comparison function.
+//
+// Unlike the priority queue, the heap orders the items themselves rather than an associated
+// priority, so it can be used as a min-heap, max-heap or anything in between.
+//
+// The heap is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package heap
+
+import (
+	"errors"
+	"iter"
+)
+
+// Heap is a generic binary heap, the item at the top of the heap is always the one
+// that is "least" according to the heap's less function.
+//
+// A Heap should be instantiated by the New function and not directly.
+type Heap[T any] struct {
+	less      func(a, b T) bool // Reports whether a should be closer to the top than b
+	container []T               // Underlying slice
+}
+
+// New builds and returns a new, empty [Heap] ordered by less.
+//
+// less should report whether a should be popped before b, so for a min-heap
+// of integers:
+//
+//	h := heap.New(func(a, b int) bool { return a < b })
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less, container: make([]T, 0)}
+}
+
+// WithCapacity builds and returns a new [Heap] ordered by less, with the given capacity.
+//
+// This can be a useful performance improvement when the expected maximum size of the heap is
+// known ahead of time as it eliminates the need for reallocation.
+func WithCapacity[T any](capacity int, less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less, container: make([]T, 0, capacity)}
+}
+
+// From builds a [Heap] ordered by less from an existing slice of items.
+//
+// This is more performant than creating a new empty Heap and using Push as the
+// heap is built in one pass. The slice is copied and left unmodified.
+func From[T any](items []T, less func(a, b T) bool) *Heap[T] {
+	heap := WithCapacity(len(items), less)
+	heap.container = append(heap.container, items...)
+
+	// Heapify the container
+	heap.init()
+
+	return heap
+}
+
+// Collect builds a [Heap] ordered by less from an iterator of items.
+func Collect[T any](items iter.Seq[T], less func(a, b T) bool) *Heap[T] {
+	heap := New(less)
+	for item := range items {
+		heap.container = append(heap.container, item)
+	}
+
+	// Heapify the container
+	heap.init()
+
+	return heap
+}
+
+// Push adds an item to the heap.
+func (h *Heap[T]) Push(item T) {
+	h.container = append(h.container, item)
+	h.siftUp(len(h.container) - 1)
+}
+
+// Pop removes and returns the item at the top of the heap.
+//
+// If the heap is empty, an error will be returned.
+func (h *Heap[T]) Pop() (T, error) {
+	if len(h.container) == 0 {
+		var zero T
+
+		return zero, errors.New("pop from empty heap")
+	}
+
+	// Swap the first (top) and last element
+	n := len(h.container) - 1
+	h.swap(0, n)
+
+	// Return the last element (now the top) and trim the heap
+	item := h.container[n]
+
+	var zero T
+
+	h.container[n] = zero // Don't hold on to the item so it may be garbage collected
+	h.container = h.container[:n]
+
+	// Update heap order
+	h.siftDown(0, n)
+
+	return item, nil
+}
+
+// Peek returns the item at the top of the heap without removing it.
+//
+// If the heap is empty, an error will be returned.
+func (h *Heap[T]) Peek() (T, error) {
+	if len(h.container) == 0 {
+		var zero T
+
+		return zero, errors.New("peek from empty heap")
+	}
+
+	return h.container[0], nil
+}
+
+// Len returns the number of items currently in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.container)
+}
+
+// IsEmpty returns whether the heap is empty.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.container) == 0
+}
+
+// init heapifies the underlying container, establishing the heap invariants required by
+// the other methods.
+func (h *Heap[T]) init() {
+	n := len(h.container)
+	for i := n/2 - 1; i >= 0; i-- { //nolint: mnd // Dividing by 2, surely I don't have to put 2 in a constant?
+		h.siftDown(i, n)
+	}
+}
+
+// siftUp moves an item (by index) up the heap until it's in the correct position.
+func (h *Heap[T]) siftUp(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2 //nolint: mnd // Dividing by 2, surely I don't have to put 2 in a constant?
+		if !h.less(h.container[index], h.container[parent]) {
+			break
+		}
+
+		h.swap(parent, index)
+		index = parent
+	}
+}
+
+// siftDown moves an item (by index) down the heap until it's in the correct position.
+func (h *Heap[T]) siftDown(index, length int) {
+	i := index
+
+	for {
+		leftChild := 2*i + 1 //nolint: mnd // 2 comes up a lot in binary heaps
+		if leftChild >= length || leftChild < 0 {
+			break
+		}
+
+		toSwap := leftChild
+		if rightChild := leftChild + 1; rightChild < length && h.less(h.container[rightChild], h.container[leftChild]) {
+			toSwap = rightChild
+		}
+
+		if !h.less(h.container[toSwap], h.container[i]) {
+			break
+		}
+
+		h.swap(i, toSwap)
+		i = toSwap
+	}
+}
+
+// swap swaps two items in the heap by index.
+func (h *Heap[T]) swap(i, j int) {
+	h.container[i], h.container[j] = h.container[j], h.container[i]
+}
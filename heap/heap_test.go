@@ -0,0 +1,100 @@
+package heap_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/heap"
+	"github.com/FollowTheProcess/test"
+)
+
+func minInt(a, b int) bool { return a < b }
+
+func TestNew(t *testing.T) {
+	h := heap.New(minInt)
+	test.Equal(t, h.Len(), 0)
+	test.True(t, h.IsEmpty())
+
+	_, err := h.Pop()
+	test.Err(t, err)
+
+	_, err = h.Peek()
+	test.Err(t, err)
+}
+
+func TestPushPop(t *testing.T) {
+	h := heap.New(minInt)
+
+	for _, item := range []int{5, 3, 8, 1, 9, 2, 7} {
+		h.Push(item)
+	}
+
+	test.Equal(t, h.Len(), 7)
+
+	top, err := h.Peek()
+	test.Ok(t, err)
+	test.Equal(t, top, 1)
+	test.Equal(t, h.Len(), 7) // Peek should not remove
+
+	var got []int
+
+	for !h.IsEmpty() {
+		item, err := h.Pop()
+		test.Ok(t, err)
+
+		got = append(got, item)
+	}
+
+	test.EqualFunc(t, got, []int{1, 2, 3, 5, 7, 8, 9}, slices.Equal)
+}
+
+func TestMaxHeap(t *testing.T) {
+	h := heap.From([]string{"b", "d", "a", "c"}, func(a, b string) bool { return a > b })
+
+	var got []string
+
+	for !h.IsEmpty() {
+		item, err := h.Pop()
+		test.Ok(t, err)
+
+		got = append(got, item)
+	}
+
+	test.EqualFunc(t, got, []string{"d", "c", "b", "a"}, slices.Equal)
+}
+
+func TestFrom(t *testing.T) {
+	items := []int{5, 3, 8, 1, 9, 2, 7}
+	h := heap.From(items, minInt)
+
+	test.Equal(t, h.Len(), 7)
+	test.EqualFunc(t, items, []int{5, 3, 8, 1, 9, 2, 7}, slices.Equal) // Should not modify items
+
+	top, err := h.Pop()
+	test.Ok(t, err)
+	test.Equal(t, top, 1)
+}
+
+func TestCollect(t *testing.T) {
+	h := heap.Collect(slices.Values([]int{5, 3, 8, 1}), minInt)
+
+	test.Equal(t, h.Len(), 4)
+
+	top, err := h.Pop()
+	test.Ok(t, err)
+	test.Equal(t, top, 1)
+
+	next, err := h.Pop()
+	test.Ok(t, err)
+	test.Equal(t, next, 3)
+}
+
+func BenchmarkPush(b *testing.B) {
+	h := heap.New(minInt)
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		h.Push(b.N - i)
+	}
+}
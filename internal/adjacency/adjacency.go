@@ -0,0 +1,254 @@
+// Package adjacency implements the vertex and edge bookkeeping shared by the directed
+// graphs in this module, so that dag.Graph and graph.Graph differ only in the
+// invariants and algorithms they layer on top.
+//
+// Edges are recorded in both directions, each vertex knows the ids of its parents and of
+// its children, so walking an edge either way is cheap.
+package adjacency
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/FollowTheProcess/collections/set"
+)
+
+// Vertex is a single node in the graph, and holds the underlying data
+// we want to represent in the graph.
+type Vertex[K comparable, T any] struct {
+	Parents  *set.Set[K] // The ids of the direct parents of this vertex
+	Children *set.Set[K] // The ids of the direct children of this vertex
+	Item     T           // The actual data
+}
+
+// Graph is a directed graph of vertices, each identified by a unique id of type 'K'
+// and holding an item of type 'T'.
+//
+// It places no restrictions on the shape of the graph, cycles and self loops are allowed.
+type Graph[K comparable, T any] struct {
+	Vertices map[K]*Vertex[K, T] // The map of id -> vertex
+	edges    int                 // The current number of edges in the graph
+}
+
+// New creates and returns a new, empty [Graph].
+//
+// It is returned by value as it is intended to be held directly by the public graph types.
+func New[K comparable, T any]() Graph[K, T] {
+	return Graph[K, T]{
+		Vertices: make(map[K]*Vertex[K, T]),
+	}
+}
+
+// WithCapacity creates and returns a new [Graph] with room for capacity vertices.
+func WithCapacity[K comparable, T any](capacity int) Graph[K, T] {
+	return Graph[K, T]{
+		Vertices: make(map[K]*Vertex[K, T], capacity),
+	}
+}
+
+// Order returns the number of vertices in the graph.
+func (g *Graph[K, T]) Order() int {
+	return len(g.Vertices)
+}
+
+// Size returns the number of edges in the graph.
+func (g *Graph[K, T]) Size() int {
+	return g.edges
+}
+
+// AddVertex adds a vertex holding item to the graph.
+//
+// If the vertex already exists, an error will be returned.
+func (g *Graph[K, T]) AddVertex(id K, item T) error {
+	if _, exists := g.Vertices[id]; exists {
+		return fmt.Errorf("vertex with id '%v' already exists", id)
+	}
+
+	// nil safety
+	if g.Vertices == nil {
+		g.Vertices = make(map[K]*Vertex[K, T])
+	}
+
+	g.Vertices[id] = &Vertex[K, T]{
+		Parents:  set.New[K](),
+		Children: set.New[K](),
+		Item:     item,
+	}
+
+	return nil
+}
+
+// GetVertex returns the item stored in a vertex.
+//
+// If the vertex does not exist, an error will be returned.
+func (g *Graph[K, T]) GetVertex(id K) (T, error) {
+	vertex, exists := g.Vertices[id]
+	if !exists {
+		var zero T
+
+		return zero, fmt.Errorf("vertex with id '%v' not in graph", id)
+	}
+
+	return vertex.Item, nil
+}
+
+// ContainsVertex reports whether a vertex with the given id is present in the graph.
+func (g *Graph[K, T]) ContainsVertex(id K) bool {
+	_, exists := g.Vertices[id]
+
+	return exists
+}
+
+// RemoveVertex removes a vertex and all of its edges from the graph.
+//
+// If the vertex does not exist, an error will be returned.
+func (g *Graph[K, T]) RemoveVertex(id K) error {
+	vertex, exists := g.Vertices[id]
+	if !exists {
+		return fmt.Errorf("vertex with id '%v' not in graph", id)
+	}
+
+	for child := range vertex.Children.All() {
+		g.Vertices[child].Parents.Remove(id)
+		g.edges--
+	}
+
+	for parent := range vertex.Parents.All() {
+		// A self loop has already been removed above
+		if g.Vertices[parent].Children.Remove(id) {
+			g.edges--
+		}
+	}
+
+	delete(g.Vertices, id)
+
+	return nil
+}
+
+// AddEdge creates a directed connection from the vertex with id 'from' to the
+// one with id 'to'.
+//
+// If either vertex does not exist, an error will be returned. Adding an edge that
+// already exists is a no-op.
+func (g *Graph[K, T]) AddEdge(from, to K) error {
+	parent, exists := g.Vertices[from]
+	if !exists {
+		return fmt.Errorf("parent vertex with id '%v' not in graph", from)
+	}
+
+	child, exists := g.Vertices[to]
+	if !exists {
+		return fmt.Errorf("child vertex with id '%v' not in graph", to)
+	}
+
+	if parent.Children.Insert(to) {
+		child.Parents.Insert(from)
+		g.edges++
+	}
+
+	return nil
+}
+
+// RemoveEdge removes the directed connection from the vertex with id 'from'
+// to the one with id 'to'.
+//
+// If either vertex, or the edge itself, does not exist, an error will be returned.
+func (g *Graph[K, T]) RemoveEdge(from, to K) error {
+	parent, exists := g.Vertices[from]
+	if !exists {
+		return fmt.Errorf("parent vertex with id '%v' not in graph", from)
+	}
+
+	child, exists := g.Vertices[to]
+	if !exists {
+		return fmt.Errorf("child vertex with id '%v' not in graph", to)
+	}
+
+	if !parent.Children.Remove(to) {
+		return fmt.Errorf("no edge from '%v' to '%v'", from, to)
+	}
+
+	child.Parents.Remove(from)
+	g.edges--
+
+	return nil
+}
+
+// ContainsEdge reports whether there is a directed connection from the vertex
+// with id 'from' to the one with id 'to'.
+func (g *Graph[K, T]) ContainsEdge(from, to K) bool {
+	parent, exists := g.Vertices[from]
+	if !exists {
+		return false
+	}
+
+	return parent.Children.Contains(to)
+}
+
+// Successors returns an iterator over the ids of the vertices that the vertex with
+// the given id has an edge to.
+//
+// If the vertex does not exist, an error will be returned.
+func (g *Graph[K, T]) Successors(id K) (iter.Seq[K], error) {
+	vertex, exists := g.Vertices[id]
+	if !exists {
+		return nil, fmt.Errorf("vertex with id '%v' not in graph", id)
+	}
+
+	return vertex.Children.All(), nil
+}
+
+// Predecessors returns an iterator over the ids of the vertices that have an edge
+// to the vertex with the given id.
+//
+// If the vertex does not exist, an error will be returned.
+func (g *Graph[K, T]) Predecessors(id K) (iter.Seq[K], error) {
+	vertex, exists := g.Vertices[id]
+	if !exists {
+		return nil, fmt.Errorf("vertex with id '%v' not in graph", id)
+	}
+
+	return vertex.Parents.All(), nil
+}
+
+// InDegrees returns a map of vertex id to the number of edges into that vertex, along
+// with the ids of the vertices with no inbound edges at all.
+//
+// It is the starting point for Kahn's algorithm, which consumes the in-degrees rather
+// than the graph itself so the graph is left untouched.
+func (g *Graph[K, T]) InDegrees() (inDegree map[K]int, roots []K) {
+	inDegree = make(map[K]int, len(g.Vertices))
+
+	for id, vertex := range g.Vertices {
+		inDegree[id] = vertex.Parents.Size()
+		if inDegree[id] == 0 {
+			roots = append(roots, id)
+		}
+	}
+
+	return inDegree, roots
+}
+
+// IsAcyclic reports whether the graph contains no cycles.
+func (g *Graph[K, T]) IsAcyclic() bool {
+	// Kahn's algorithm, without building the result: if we can visit every
+	// vertex by repeatedly removing vertices with no remaining parents, there is no cycle
+	inDegree, ready := g.InDegrees()
+
+	visited := 0
+
+	for len(ready) != 0 {
+		id := ready[len(ready)-1]
+		ready = ready[:len(ready)-1]
+		visited++
+
+		for child := range g.Vertices[id].Children.All() {
+			inDegree[child]--
+			if inDegree[child] == 0 {
+				ready = append(ready, child)
+			}
+		}
+	}
+
+	return visited == len(g.Vertices)
+}
@@ -0,0 +1,47 @@
+package adjacency_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/internal/adjacency"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestInDegrees(t *testing.T) {
+	g := adjacency.New[string, int]()
+	test.Ok(t, g.AddVertex("one", 1))
+	test.Ok(t, g.AddVertex("two", 2))
+	test.Ok(t, g.AddVertex("three", 3))
+
+	test.Ok(t, g.AddEdge("one", "two"))
+	test.Ok(t, g.AddEdge("one", "three"))
+	test.Ok(t, g.AddEdge("two", "three"))
+
+	inDegree, roots := g.InDegrees()
+	test.EqualFunc(t, roots, []string{"one"}, slices.Equal)
+	test.Equal(t, inDegree["two"], 1)
+	test.Equal(t, inDegree["three"], 2)
+
+	inDegree["three"] = 0
+	test.Equal(t, g.Size(), 3) // Consuming the in-degrees leaves the graph alone
+}
+
+func TestRemoveVertexSelfLoop(t *testing.T) {
+	var g adjacency.Graph[string, int] // Zero value is usable
+	test.Ok(t, g.AddVertex("one", 1))
+	test.Ok(t, g.AddVertex("two", 2))
+
+	test.Ok(t, g.AddEdge("one", "one"))
+	test.Ok(t, g.AddEdge("one", "two"))
+	test.Ok(t, g.AddEdge("two", "one"))
+	test.Equal(t, g.Size(), 3)
+
+	test.Ok(t, g.RemoveVertex("one"))
+	test.Equal(t, g.Size(), 0) // Self loop only counted once
+	test.Equal(t, g.Order(), 1)
+
+	predecessors, err := g.Predecessors("two")
+	test.Ok(t, err)
+	test.Equal(t, len(slices.Collect(predecessors)), 0)
+}
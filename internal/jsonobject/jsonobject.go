@@ -0,0 +1,168 @@
+// Package jsonobject implements encoding and decoding of key, value entries to and from
+// JSON objects with the key order preserved, shared by the collections that need it.
+//
+// Keys follow the same rules as [encoding/json] does for map keys: they must be strings,
+// integers, or implement [encoding.TextMarshaler] (and [encoding.TextUnmarshaler] to be decoded).
+package jsonobject
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// Encode encodes entries as a JSON object, with the keys in the order they are yielded.
+func Encode[K comparable, V any](entries iter.Seq2[K, V]) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+
+	first := true
+	for key, value := range entries {
+		if !first {
+			buf.WriteByte(',')
+		}
+
+		first = false
+
+		if err := writeEntry(buf, key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// writeEntry writes a single "key":value pair of a JSON object to buf.
+func writeEntry[K comparable, V any](buf *bytes.Buffer, key K, value V) error {
+	encodedKey, err := marshalKey(key)
+	if err != nil {
+		return err
+	}
+
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("could not encode value for key %v: %w", key, err)
+	}
+
+	buf.Write(encodedKey)
+	buf.WriteByte(':')
+	buf.Write(encodedValue)
+
+	return nil
+}
+
+// Decode decodes the JSON object in data, calling fn for each key in the order
+// they appear. fn must decode exactly one value (the one for that key) from dec.
+func Decode[K comparable](data []byte, fn func(key K, dec *json.Decoder) error) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected a JSON object, got %v", token)
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		raw, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("expected a string key, got %v", token)
+		}
+
+		key, err := unmarshalKey[K](raw)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(key, dec); err != nil {
+			return err
+		}
+	}
+
+	// Consume the closing '}'
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	if dec.More() {
+		return errors.New("unexpected data after JSON object")
+	}
+
+	return nil
+}
+
+// marshalKey encodes key as a quoted JSON object key, following the same rules
+// as encoding/json does for map keys.
+func marshalKey[K comparable](key K) ([]byte, error) {
+	if marshaler, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return nil, fmt.Errorf("could not encode key %v: %w", key, err)
+		}
+
+		return json.Marshal(string(text))
+	}
+
+	value := reflect.ValueOf(key)
+	switch value.Kind() {
+	case reflect.String:
+		return json.Marshal(value.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return json.Marshal(strconv.FormatInt(value.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return json.Marshal(strconv.FormatUint(value.Uint(), 10))
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// unmarshalKey decodes raw, the unquoted text of a JSON object key, into a K
+// following the same rules as encoding/json does for map keys.
+func unmarshalKey[K comparable](raw string) (K, error) {
+	var key K
+	if unmarshaler, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		if err := unmarshaler.UnmarshalText([]byte(raw)); err != nil {
+			return key, fmt.Errorf("could not decode key %q: %w", raw, err)
+		}
+
+		return key, nil
+	}
+
+	value := reflect.ValueOf(&key).Elem()
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, value.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("could not decode key %q: %w", raw, err)
+		}
+
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(raw, 10, value.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("could not decode key %q: %w", raw, err)
+		}
+
+		value.SetUint(n)
+	default:
+		return key, fmt.Errorf("unsupported key type %T", key)
+	}
+
+	return key, nil
+}
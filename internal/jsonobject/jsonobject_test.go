@@ -0,0 +1,115 @@
+package jsonobject_test
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/internal/jsonobject"
+	"github.com/FollowTheProcess/test"
+)
+
+type upper string
+
+func (u upper) MarshalText() ([]byte, error) {
+	return []byte("UP-" + string(u)), nil
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string                 // Name of the test case
+		encode  func() ([]byte, error) // Performs the encoding under test
+		want    string                 // Expected JSON
+		wantErr bool                   // Whether we want an error
+	}{
+		{
+			name: "string keys",
+			encode: func() ([]byte, error) {
+				return jsonobject.Encode(maps.All(map[string]int{"one": 1}))
+			},
+			want: `{"one":1}`,
+		},
+		{
+			name: "int keys",
+			encode: func() ([]byte, error) {
+				return jsonobject.Encode(maps.All(map[int8]bool{-1: true}))
+			},
+			want: `{"-1":true}`,
+		},
+		{
+			name: "uint keys",
+			encode: func() ([]byte, error) {
+				return jsonobject.Encode(maps.All(map[uint]string{1: "one"}))
+			},
+			want: `{"1":"one"}`,
+		},
+		{
+			name: "text marshaler keys",
+			encode: func() ([]byte, error) {
+				return jsonobject.Encode(maps.All(map[upper]int{"a": 1}))
+			},
+			want: `{"UP-a":1}`,
+		},
+		{
+			name: "empty",
+			encode: func() ([]byte, error) {
+				return jsonobject.Encode(maps.All(map[string]int{}))
+			},
+			want: `{}`,
+		},
+		{
+			name: "unsupported key",
+			encode: func() ([]byte, error) {
+				return jsonobject.Encode(maps.All(map[bool]int{true: 1}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad value",
+			encode: func() ([]byte, error) {
+				return jsonobject.Encode(maps.All(map[string]func(){"fn": nil}))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.encode()
+			test.WantErr(t, err, tt.wantErr)
+			test.Equal(t, string(got), tt.want)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	var keys []int
+
+	var values []string
+
+	err := jsonobject.Decode([]byte(`{"3": "three", "1": "one"}`), func(key int, dec *json.Decoder) error {
+		var value string
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		keys = append(keys, key)
+		values = append(values, value)
+
+		return nil
+	})
+	test.Ok(t, err)
+	test.EqualFunc(t, keys, []int{3, 1}, slices.Equal) // Document order
+	test.EqualFunc(t, values, []string{"three", "one"}, slices.Equal)
+
+	skip := func(_ int, dec *json.Decoder) error {
+		var value any
+
+		return dec.Decode(&value)
+	}
+
+	test.Err(t, jsonobject.Decode([]byte(`[1, 2]`), skip))
+	test.Err(t, jsonobject.Decode([]byte(`{"x": 1}`), skip)) // Not an int key
+	test.Err(t, jsonobject.Decode([]byte(`{"1": 1} {}`), skip))
+}
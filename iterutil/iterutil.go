@@ -0,0 +1,143 @@
+// Package iterutil provides lazy combinators over [iter.Seq] and [iter.Seq2], for transforming
+// the iterators returned by the collections in this module without collecting them into slices.
+//
+// None of the functions in this package consume their input until the returned iterator is ranged over.
+package iterutil
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Map returns an iterator over the result of calling fn on each item in seq.
+//
+//	s := set.From([]string{"hello", "there"})
+//	upper := iterutil.Map(s.All(), strings.ToUpper)
+func Map[T, U any](seq iter.Seq[T], fn func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range seq {
+			if !yield(fn(item)) {
+				return
+			}
+		}
+	}
+}
+
+// Map2 returns an iterator over the result of calling fn on each pair in seq.
+func Map2[K1, V1, K2, V2 any](seq iter.Seq2[K1, V1], fn func(K1, V1) (K2, V2)) iter.Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range seq {
+			if !yield(fn(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns an iterator over the items in seq for which keep returns true.
+//
+//	evens := iterutil.Filter(s.All(), func(n int) bool { return n%2 == 0 })
+func Filter[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range seq {
+			if keep(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Filter2 returns an iterator over the pairs in seq for which keep returns true.
+func Filter2[K, V any](seq iter.Seq2[K, V], keep func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if keep(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce combines the items in seq into a single value by calling fn with the
+// running accumulator and each item in turn, starting from initial.
+//
+// Unlike the other functions in this package, Reduce consumes seq immediately.
+//
+//	total := iterutil.Reduce(s.All(), 0, func(sum, n int) int { return sum + n })
+func Reduce[T, A any](seq iter.Seq[T], initial A, fn func(A, T) A) A {
+	acc := initial
+	for item := range seq {
+		acc = fn(acc, item)
+	}
+
+	return acc
+}
+
+// Take returns an iterator over at most the first n items in seq.
+//
+// If n is 0 or negative, the returned iterator yields nothing.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for item := range seq {
+			if !yield(item) {
+				return
+			}
+
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns an iterator over consecutive chunks of up to size items from seq. All
+// but the last chunk will have exactly size items.
+//
+// Each chunk is a newly allocated slice, so it is safe to retain. Chunk panics
+// if size is less than 1.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic(fmt.Sprintf("cannot chunk into sizes less than 1, got %d", size))
+	}
+
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for item := range seq {
+			chunk = append(chunk, item)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+
+				chunk = make([]T, 0, size)
+			}
+		}
+
+		if len(chunk) != 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip returns an iterator over pairs of items drawn from a and b in lockstep.
+//
+// The returned iterator stops as soon as either a or b is exhausted.
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(b)
+		defer stop()
+
+		for itemA := range a {
+			itemB, ok := next()
+			if !ok || !yield(itemA, itemB) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package iterutil_test
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/iterutil"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestMap(t *testing.T) {
+	got := slices.Collect(iterutil.Map(slices.Values([]string{"hello", "there"}), strings.ToUpper))
+	test.EqualFunc(t, got, []string{"HELLO", "THERE"}, slices.Equal)
+
+	// Early return
+	for range iterutil.Map(slices.Values([]int{1, 2, 3}), strconv.Itoa) {
+		break
+	}
+}
+
+func TestMap2(t *testing.T) {
+	seq := iterutil.Map2(slices.All([]string{"a", "b"}), func(i int, s string) (string, int) {
+		return s, i
+	})
+
+	test.EqualFunc(t, maps.Collect(seq), map[string]int{"a": 0, "b": 1}, maps.Equal)
+}
+
+func TestFilter(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	got := slices.Collect(iterutil.Filter(slices.Values([]int{1, 2, 3, 4, 5, 6}), even))
+	test.EqualFunc(t, got, []int{2, 4, 6}, slices.Equal)
+
+	for range iterutil.Filter(slices.Values([]int{2, 4}), even) {
+		break
+	}
+}
+
+func TestFilter2(t *testing.T) {
+	seq := iterutil.Filter2(slices.All([]string{"a", "b", "c"}), func(i int, _ string) bool {
+		return i != 1
+	})
+
+	test.EqualFunc(t, maps.Collect(seq), map[int]string{0: "a", 2: "c"}, maps.Equal)
+}
+
+func TestReduce(t *testing.T) {
+	sum := iterutil.Reduce(slices.Values([]int{1, 2, 3, 4}), 0, func(acc, n int) int { return acc + n })
+	test.Equal(t, sum, 10)
+
+	joined := iterutil.Reduce(slices.Values([]int{1, 2, 3}), "", func(acc string, n int) string {
+		return acc + strconv.Itoa(n)
+	})
+	test.Equal(t, joined, "123")
+
+	empty := iterutil.Reduce(slices.Values([]int{}), 42, func(acc, n int) int { return acc + n })
+	test.Equal(t, empty, 42)
+}
+
+func TestTake(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	test.EqualFunc(t, slices.Collect(iterutil.Take(slices.Values(items), 3)), []int{1, 2, 3}, slices.Equal)
+	test.EqualFunc(t, slices.Collect(iterutil.Take(slices.Values(items), 10)), items, slices.Equal)
+	test.Equal(t, len(slices.Collect(iterutil.Take(slices.Values(items), 0))), 0)
+	test.Equal(t, len(slices.Collect(iterutil.Take(slices.Values(items), -1))), 0)
+}
+
+func TestChunk(t *testing.T) {
+	got := slices.Collect(iterutil.Chunk(slices.Values([]int{1, 2, 3, 4, 5}), 2))
+
+	test.Equal(t, len(got), 3)
+	test.EqualFunc(t, got[0], []int{1, 2}, slices.Equal)
+	test.EqualFunc(t, got[1], []int{3, 4}, slices.Equal)
+	test.EqualFunc(t, got[2], []int{5}, slices.Equal)
+
+	test.Equal(t, len(slices.Collect(iterutil.Chunk(slices.Values([]int{}), 2))), 0)
+
+	defer func() {
+		test.True(t, recover() != nil) // Chunk size 0 should panic
+	}()
+
+	iterutil.Chunk(slices.Values([]int{1}), 0)
+}
+
+func TestZip(t *testing.T) {
+	names := []string{"one", "two", "three"}
+	numbers := []int{1, 2}
+
+	got := maps.Collect(iterutil.Zip(slices.Values(names), slices.Values(numbers)))
+	test.EqualFunc(t, got, map[string]int{"one": 1, "two": 2}, maps.Equal) // Stops at the shortest
+
+	for range iterutil.Zip(slices.Values(names), slices.Values(names)) {
+		break
+	}
+}
@@ -44,15 +44,15 @@ func New[T any]() *List[T] {
 // Append adds an item to the end (tail) of the list, returning the list [Node] it was inserted into.
 // It may be retrieved afterwards with l.Last().
 func (l *List[T]) Append(item T) *Node[T] {
-	node := NewNode(item)
-	if l.last != nil {
-		// List has items in it, insert after last
-		l.insertAfter(l.last, node)
-	} else {
-		// Empty list
-		l.Prepend(item)
+	if l.last == nil {
+		// Empty list, appending and prepending are the same thing
+		return l.Prepend(item)
 	}
 
+	// List has items in it, insert after last
+	node := NewNode(item)
+	l.insertAfter(l.last, node)
+
 	return node
 }
 
@@ -152,6 +152,30 @@ func (l *List[T]) Remove(node *Node[T]) *Node[T] {
 	return node
 }
 
+// MoveToFront moves node, which must already be in the list, to the start (head) of the list.
+//
+// Only the links between nodes are changed, nothing is allocated.
+func (l *List[T]) MoveToFront(node *Node[T]) {
+	if l.first == node {
+		return
+	}
+
+	l.Remove(node)
+	l.insertBefore(l.first, node)
+}
+
+// MoveToBack moves node, which must already be in the list, to the end (tail) of the list.
+//
+// Only the links between nodes are changed, nothing is allocated.
+func (l *List[T]) MoveToBack(node *Node[T]) {
+	if l.last == node {
+		return
+	}
+
+	l.Remove(node)
+	l.insertAfter(l.last, node)
+}
+
 // All returns an iterator over the items in the list, in order.
 func (l *List[T]) All() iter.Seq[T] {
 	return func(yield func(T) bool) {
@@ -236,6 +236,38 @@ func TestRemove(t *testing.T) {
 	test.EqualFunc(t, slices.Collect(list.All()), want, slices.Equal)
 }
 
+func TestRemoveFirstAppended(t *testing.T) {
+	list := list.New[string]()
+	one := list.Append("one") // Appending to an empty list
+	list.Append("two")
+
+	list.Remove(one)
+	test.Equal(t, list.Len(), 1)
+
+	want := []string{"two"}
+	test.EqualFunc(t, slices.Collect(list.All()), want, slices.Equal)
+}
+
+func TestMove(t *testing.T) {
+	list := list.New[string]()
+	hello := list.Append("hello")
+	list.Append("there")
+	kenobi := list.Append("kenobi")
+
+	list.MoveToFront(kenobi)
+	test.EqualFunc(t, slices.Collect(list.All()), []string{"kenobi", "hello", "there"}, slices.Equal)
+
+	list.MoveToBack(hello)
+	test.EqualFunc(t, slices.Collect(list.All()), []string{"kenobi", "there", "hello"}, slices.Equal)
+	test.EqualFunc(t, slices.Collect(list.Backwards()), []string{"hello", "there", "kenobi"}, slices.Equal)
+
+	// Already in place, should be no-ops
+	list.MoveToFront(kenobi)
+	list.MoveToBack(hello)
+	test.EqualFunc(t, slices.Collect(list.All()), []string{"kenobi", "there", "hello"}, slices.Equal)
+	test.Equal(t, list.Len(), 3)
+}
+
 func TestItems(t *testing.T) {
 	list := list.New[int]()
 
@@ -0,0 +1,107 @@
+// Package lru implements a generic, fixed capacity, least recently used (LRU) cache.
+//
+// Once the cache is full, adding a new key evicts the entry that was least recently
+// accessed to make room for it.
+//
+// The cache is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package lru
+
+import (
+	"github.com/FollowTheProcess/collections/orderedmap"
+)
+
+// Cache is a fixed capacity, least recently used cache.
+//
+// A Cache should be instantiated by the New function and not directly.
+type Cache[K comparable, V any] struct {
+	entries  *orderedmap.Map[K, V] // Entries ordered from least to most recently used
+	onEvict  func(key K, value V)  // Optional callback, called when an entry is evicted
+	capacity int                   // The maximum number of entries in the cache
+}
+
+// New creates and returns a new [Cache] that holds at most capacity entries.
+//
+// A capacity < 1 is treated as 1.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	capacity = max(capacity, 1)
+
+	return &Cache[K, V]{
+		entries:  orderedmap.WithCapacity[K, V](capacity),
+		capacity: capacity,
+	}
+}
+
+// NewWithEvict creates and returns a new [Cache] that holds at most capacity entries,
+// calling onEvict with the key and value of every entry evicted to make room for another.
+//
+// onEvict is not called for entries removed explicitly with [Cache.Remove].
+func NewWithEvict[K comparable, V any](capacity int, onEvict func(key K, value V)) *Cache[K, V] {
+	cache := New[K, V](capacity)
+	cache.onEvict = onEvict
+
+	return cache
+}
+
+// Get returns the value stored against key and a boolean to indicate presence, like
+// the standard Go map.
+//
+// If the key is present, it is marked as the most recently used.
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	value, ok = c.entries.Get(key)
+	if !ok {
+		return value, false
+	}
+
+	c.entries.MoveToBack(key)
+
+	return value, true
+}
+
+// Peek returns the value stored against key and a boolean to indicate presence
+// without marking it as recently used.
+func (c *Cache[K, V]) Peek(key K) (value V, ok bool) {
+	return c.entries.Get(key)
+}
+
+// Contains reports whether key is in the cache, without marking it as recently used.
+func (c *Cache[K, V]) Contains(key K) bool {
+	return c.entries.Contains(key)
+}
+
+// Put stores value against key in the cache, marking it as the most recently used.
+//
+// If adding the key takes the cache over capacity, the least recently used entry
+// is evicted, and Put reports true.
+func (c *Cache[K, V]) Put(key K, value V) (evicted bool) {
+	// An update also moves the key to the most recently used end
+	c.entries.InsertBump(key, value)
+
+	if c.entries.Size() <= c.capacity {
+		return false
+	}
+
+	oldestKey, oldestValue, _ := c.entries.PopOldest()
+
+	if c.onEvict != nil {
+		c.onEvict(oldestKey, oldestValue)
+	}
+
+	return true
+}
+
+// Remove removes key from the cache, returning the stored value and a boolean
+// to indicate whether it was in the cache to begin with.
+func (c *Cache[K, V]) Remove(key K) (value V, existed bool) {
+	return c.entries.Remove(key)
+}
+
+// Len returns the number of entries currently in the cache.
+func (c *Cache[K, V]) Len() int {
+	return c.entries.Size()
+}
+
+// Cap returns the maximum number of entries the cache can hold.
+func (c *Cache[K, V]) Cap() int {
+	return c.capacity
+}
@@ -0,0 +1,95 @@
+package lru_test
+
+import (
+	"testing"
+
+	"github.com/FollowTheProcess/collections/lru"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestPutGet(t *testing.T) {
+	cache := lru.New[string, int](2)
+	test.Equal(t, cache.Cap(), 2)
+	test.Equal(t, cache.Len(), 0)
+
+	_, ok := cache.Get("missing")
+	test.False(t, ok)
+
+	test.False(t, cache.Put("one", 1))
+	test.False(t, cache.Put("two", 2))
+	test.Equal(t, cache.Len(), 2)
+
+	one, ok := cache.Get("one")
+	test.True(t, ok)
+	test.Equal(t, one, 1)
+
+	// "two" is now least recently used so should get evicted
+	test.True(t, cache.Put("three", 3))
+	test.Equal(t, cache.Len(), 2)
+
+	test.False(t, cache.Contains("two"))
+	test.True(t, cache.Contains("one"))
+	test.True(t, cache.Contains("three"))
+}
+
+func TestUpdatePromotes(t *testing.T) {
+	cache := lru.New[string, int](2)
+	cache.Put("one", 1)
+	cache.Put("two", 2)
+
+	test.False(t, cache.Put("one", 100)) // Update, no eviction
+
+	cache.Put("three", 3) // Should evict "two"
+
+	test.False(t, cache.Contains("two"))
+
+	one, ok := cache.Peek("one")
+	test.True(t, ok)
+	test.Equal(t, one, 100)
+}
+
+func TestPeekDoesNotPromote(t *testing.T) {
+	cache := lru.New[string, int](2)
+	cache.Put("one", 1)
+	cache.Put("two", 2)
+
+	one, ok := cache.Peek("one")
+	test.True(t, ok)
+	test.Equal(t, one, 1)
+
+	test.True(t, cache.Contains("one"))
+
+	cache.Put("three", 3) // Should still evict "one"
+
+	test.False(t, cache.Contains("one"))
+}
+
+func TestEvictCallback(t *testing.T) {
+	var evicted []string
+
+	cache := lru.NewWithEvict(1, func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+
+	cache.Put("one", 1)
+	cache.Put("two", 2)
+	cache.Put("three", 3)
+
+	value, existed := cache.Remove("three")
+	test.True(t, existed)
+	test.Equal(t, value, 3)
+
+	test.Equal(t, len(evicted), 2) // Remove should not call the callback
+	test.Equal(t, evicted[0], "one")
+	test.Equal(t, evicted[1], "two")
+}
+
+func BenchmarkPut(b *testing.B) {
+	cache := lru.New[int, int](100)
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		cache.Put(i, i)
+	}
+}
@@ -0,0 +1,141 @@
+// Package multimap implements a map where each key may be associated with many values.
+//
+// The map is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package multimap
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// MultiMap is a map of keys to many values.
+type MultiMap[K comparable, V any] struct {
+	inner map[K][]V // The underlying map of key -> values
+	count int       // The total number of values across all keys
+}
+
+// New creates and returns a new [MultiMap].
+func New[K comparable, V any]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{inner: make(map[K][]V)}
+}
+
+// WithCapacity creates and returns a new [MultiMap] with the given capacity.
+//
+// This can be a useful performance improvement when the expected number of unique keys
+// is known ahead of time as it eliminates the need for reallocation.
+func WithCapacity[K comparable, V any](capacity int) *MultiMap[K, V] {
+	return &MultiMap[K, V]{inner: make(map[K][]V, capacity)}
+}
+
+// Add associates value with key, values are stored against a key in the order
+// in which they are added.
+//
+//	m := multimap.New[string, int]()
+//	m.Add("evens", 2)
+//	m.Add("evens", 4)
+//	m.Get("evens") // [2 4]
+func (m *MultiMap[K, V]) Add(key K, value V) {
+	// nil safety
+	if m.inner == nil {
+		m.inner = make(map[K][]V)
+	}
+
+	m.inner[key] = append(m.inner[key], value)
+	m.count++
+}
+
+// Get returns a copy of the values associated with key, in the order they were added.
+//
+// If the key is not present, nil is returned.
+func (m *MultiMap[K, V]) Get(key K) []V {
+	return slices.Clone(m.inner[key])
+}
+
+// Contains reports whether the map contains any values for the given key.
+func (m *MultiMap[K, V]) Contains(key K) bool {
+	_, exists := m.inner[key]
+
+	return exists
+}
+
+// RemoveAll removes the key and all of its associated values from the map, returning
+// the removed values.
+//
+// If the key was not present, nil is returned.
+func (m *MultiMap[K, V]) RemoveAll(key K) []V {
+	values, exists := m.inner[key]
+	if !exists {
+		return nil
+	}
+
+	delete(m.inner, key)
+	m.count -= len(values)
+
+	return values
+}
+
+// Len returns the number of unique keys in the map.
+func (m *MultiMap[K, V]) Len() int {
+	return len(m.inner)
+}
+
+// Count returns the total number of values in the map, across all keys.
+func (m *MultiMap[K, V]) Count() int {
+	return m.count
+}
+
+// Keys returns an iterator over the unique keys in the map.
+//
+// The order of the keys is non-deterministic.
+func (m *MultiMap[K, V]) Keys() iter.Seq[K] {
+	return maps.Keys(m.inner)
+}
+
+// All returns an iterator over every key, value pair in the map, a key associated with
+// many values is yielded once for each value.
+//
+// The order of the keys is non-deterministic, but values for any one key are yielded
+// in the order they were added.
+func (m *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, values := range m.inner {
+			for _, value := range values {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Remove removes the first occurrence of value associated with key, reporting
+// whether it was found. If it was the last value for key, the key is removed too.
+//
+// It is a function rather than a method as it requires the values in the map
+// to be comparable.
+//
+//	m := multimap.New[string, int]()
+//	m.Add("evens", 2)
+//	multimap.Remove(m, "evens", 2) // true
+//	m.Contains("evens") // false
+func Remove[K, V comparable](m *MultiMap[K, V], key K, value V) bool {
+	values := m.inner[key]
+
+	index := slices.Index(values, value)
+	if index == -1 {
+		return false
+	}
+
+	values = slices.Delete(values, index, index+1)
+	if len(values) == 0 {
+		delete(m.inner, key)
+	} else {
+		m.inner[key] = values
+	}
+
+	m.count--
+
+	return true
+}
@@ -0,0 +1,92 @@
+package multimap_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/multimap"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestAddGet(t *testing.T) {
+	m := multimap.New[string, int]()
+	test.Equal(t, m.Len(), 0)
+	test.Equal(t, m.Count(), 0)
+
+	m.Add("evens", 2)
+	m.Add("odds", 1)
+	m.Add("evens", 4)
+	m.Add("evens", 6)
+
+	test.Equal(t, m.Len(), 2)
+	test.Equal(t, m.Count(), 4)
+
+	test.EqualFunc(t, m.Get("evens"), []int{2, 4, 6}, slices.Equal)
+	test.EqualFunc(t, m.Get("odds"), []int{1}, slices.Equal)
+	test.Equal(t, len(m.Get("missing")), 0)
+
+	test.True(t, m.Contains("evens"))
+	test.False(t, m.Contains("missing"))
+
+	// Get should return a copy
+	evens := m.Get("evens")
+	evens[0] = 100
+	test.EqualFunc(t, m.Get("evens"), []int{2, 4, 6}, slices.Equal)
+}
+
+func TestRemove(t *testing.T) {
+	m := multimap.New[string, int]()
+	m.Add("evens", 2)
+	m.Add("evens", 4)
+	m.Add("evens", 2)
+	m.Add("odds", 1)
+
+	test.True(t, multimap.Remove(m, "evens", 2))
+	test.EqualFunc(t, m.Get("evens"), []int{4, 2}, slices.Equal) // Only the first removed
+	test.Equal(t, m.Count(), 3)
+
+	test.False(t, multimap.Remove(m, "evens", 3))   // Not there
+	test.False(t, multimap.Remove(m, "missing", 1)) // Not there either
+
+	test.True(t, multimap.Remove(m, "odds", 1))
+	test.False(t, m.Contains("odds")) // Last value gone, key should be too
+	test.Equal(t, m.Len(), 1)
+}
+
+func TestRemoveAll(t *testing.T) {
+	m := multimap.New[string, int]()
+	m.Add("evens", 2)
+	m.Add("evens", 4)
+	m.Add("odds", 1)
+
+	removed := m.RemoveAll("evens")
+	test.EqualFunc(t, removed, []int{2, 4}, slices.Equal)
+	test.Equal(t, m.Count(), 1)
+	test.Equal(t, m.Len(), 1)
+
+	test.Equal(t, len(m.RemoveAll("evens")), 0)
+}
+
+func TestIterators(t *testing.T) {
+	m := multimap.New[string, int]()
+	m.Add("evens", 2)
+	m.Add("evens", 4)
+	m.Add("odds", 1)
+
+	test.EqualFunc(t, slices.Sorted(m.Keys()), []string{"evens", "odds"}, slices.Equal)
+
+	var values []int
+	for _, value := range m.All() {
+		values = append(values, value)
+	}
+
+	slices.Sort(values)
+	test.EqualFunc(t, values, []int{1, 2, 4}, slices.Equal)
+}
+
+func TestNotNew(t *testing.T) {
+	m := multimap.MultiMap[string, int]{}
+	m.Add("one", 1)
+
+	test.EqualFunc(t, m.Get("one"), []int{1}, slices.Equal)
+}
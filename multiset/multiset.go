@@ -0,0 +1,259 @@
+// Package multiset implements a multiset (or bag), that is; a set that allows duplicates,
+// tracking the multiplicity of each item.
+//
+// Where [counter] is geared towards counting occurrences, a multiset supports the full
+// algebra of bags (union, intersection, difference etc.), and interoperates with both
+// [counter.Counter] and [set.Set].
+//
+// The multiset is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package multiset
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/FollowTheProcess/collections/counter"
+	"github.com/FollowTheProcess/collections/set"
+)
+
+// Multiset is a set that allows duplicates.
+type Multiset[T comparable] struct {
+	counts map[T]int // Map of item -> multiplicity, every multiplicity is > 0
+	size   int       // The total number of items, including duplicates
+}
+
+// New builds and returns a new, empty [Multiset].
+func New[T comparable]() *Multiset[T] {
+	return &Multiset[T]{counts: make(map[T]int)}
+}
+
+// WithCapacity builds and returns a new [Multiset] with the given capacity.
+//
+// This can be a useful performance improvement when the number of distinct items
+// is known ahead of time as it eliminates the need for reallocation.
+func WithCapacity[T comparable](capacity int) *Multiset[T] {
+	return &Multiset[T]{counts: make(map[T]int, capacity)}
+}
+
+// From builds a [Multiset] from an existing slice of items, duplicates in
+// items are counted.
+func From[T comparable](items []T) *Multiset[T] {
+	multiset := New[T]()
+	for _, item := range items {
+		multiset.Add(item)
+	}
+
+	return multiset
+}
+
+// FromCounter builds a [Multiset] from the items and counts in a [counter.Counter].
+func FromCounter[T comparable](c *counter.Counter[T]) *Multiset[T] {
+	multiset := WithCapacity[T](c.Size())
+	for item, count := range c.All() {
+		multiset.AddN(item, count)
+	}
+
+	return multiset
+}
+
+// Add adds a single occurrence of item to the multiset, returning its new multiplicity.
+func (m *Multiset[T]) Add(item T) int {
+	return m.AddN(item, 1)
+}
+
+// AddN adds n occurrences of item to the multiset, returning its new multiplicity.
+//
+// If n <= 0, AddN is a no-op.
+func (m *Multiset[T]) AddN(item T, n int) int {
+	if n <= 0 {
+		return m.counts[item]
+	}
+
+	// nil safety
+	if m.counts == nil {
+		m.counts = make(map[T]int)
+	}
+
+	m.counts[item] += n
+	m.size += n
+
+	return m.counts[item]
+}
+
+// Remove removes a single occurrence of item from the multiset, returning its
+// new multiplicity. Once the multiplicity reaches 0, the item is no longer in
+// the multiset.
+//
+// If the item isn't present, this is a no-op returning 0.
+func (m *Multiset[T]) Remove(item T) int {
+	count, exists := m.counts[item]
+	if !exists {
+		return 0
+	}
+
+	m.size--
+
+	count--
+	if count == 0 {
+		delete(m.counts, item)
+
+		return 0
+	}
+
+	m.counts[item] = count
+
+	return count
+}
+
+// Count returns the multiplicity of item, or 0 if it is not present.
+func (m *Multiset[T]) Count(item T) int {
+	return m.counts[item]
+}
+
+// Contains reports whether the multiset contains at least one occurrence of item.
+func (m *Multiset[T]) Contains(item T) bool {
+	_, exists := m.counts[item]
+
+	return exists
+}
+
+// Size returns the total number of items in the multiset, including duplicates.
+func (m *Multiset[T]) Size() int {
+	return m.size
+}
+
+// Distinct returns the number of distinct items in the multiset.
+func (m *Multiset[T]) Distinct() int {
+	return len(m.counts)
+}
+
+// IsEmpty reports whether the multiset is empty.
+func (m *Multiset[T]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// All returns an iterator over the distinct items in the multiset and their
+// multiplicities, in a non-deterministic order.
+func (m *Multiset[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for item, count := range m.counts {
+			if !yield(item, count) {
+				return
+			}
+		}
+	}
+}
+
+// Set returns a [set.Set] of the distinct items in the multiset.
+func (m *Multiset[T]) Set() *set.Set[T] {
+	distinct := set.WithCapacity[T](len(m.counts))
+	for item := range m.counts {
+		distinct.Insert(item)
+	}
+
+	return distinct
+}
+
+// Counter returns a [counter.Counter] with the same items and counts as the multiset.
+func (m *Multiset[T]) Counter() *counter.Counter[T] {
+	c := counter.WithCapacity[T](len(m.counts))
+	for item, count := range m.counts {
+		c.AddN(item, count)
+	}
+
+	return c
+}
+
+// String implements [fmt.Stringer] for a [Multiset] and allows
+// it to print itself.
+func (m *Multiset[T]) String() string {
+	return fmt.Sprintf("%v", m.counts)
+}
+
+// Union returns a new [Multiset] containing every item in a or b, with the
+// multiplicity of each being the larger of its multiplicities in a and b.
+func Union[T comparable](a, b *Multiset[T]) *Multiset[T] {
+	union := WithCapacity[T](max(len(a.counts), len(b.counts)))
+	for item, count := range a.counts {
+		union.AddN(item, max(count, b.counts[item]))
+	}
+
+	for item, count := range b.counts {
+		if _, seen := a.counts[item]; !seen {
+			union.AddN(item, count)
+		}
+	}
+
+	return union
+}
+
+// Intersection returns a new [Multiset] containing the items in both a and b, with
+// the multiplicity of each being the smaller of its multiplicities in a and b.
+func Intersection[T comparable](a, b *Multiset[T]) *Multiset[T] {
+	intersection := New[T]()
+	for item, count := range a.counts {
+		intersection.AddN(item, min(count, b.counts[item]))
+	}
+
+	return intersection
+}
+
+// Difference returns a new [Multiset] containing the items in a with their multiplicities
+// reduced by their multiplicities in b. Items whose multiplicity would fall to 0 or below
+// are not included.
+func Difference[T comparable](a, b *Multiset[T]) *Multiset[T] {
+	difference := New[T]()
+	for item, count := range a.counts {
+		difference.AddN(item, count-b.counts[item])
+	}
+
+	return difference
+}
+
+// Sum returns a new [Multiset] containing every item in a or b, with the multiplicity
+// of each being the sum of its multiplicities in a and b.
+func Sum[T comparable](a, b *Multiset[T]) *Multiset[T] {
+	sum := WithCapacity[T](max(len(a.counts), len(b.counts)))
+	for item, count := range a.counts {
+		sum.AddN(item, count)
+	}
+
+	for item, count := range b.counts {
+		sum.AddN(item, count)
+	}
+
+	return sum
+}
+
+// IsSubset returns whether a is a subset of b i.e. every item in a occurs in b
+// at least as many times as it does in a.
+func IsSubset[T comparable](a, b *Multiset[T]) bool {
+	if a.size > b.size {
+		return false
+	}
+
+	for item, count := range a.counts {
+		if b.counts[item] < count {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal returns whether a and b contain exactly the same items with the
+// same multiplicities.
+//
+// If either of the two multisets are nil, Equal returns false.
+func Equal[T comparable](a, b *Multiset[T]) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	if a.size != b.size || len(a.counts) != len(b.counts) {
+		return false
+	}
+
+	return IsSubset(a, b)
+}
@@ -0,0 +1,89 @@
+package multiset_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/counter"
+	"github.com/FollowTheProcess/collections/multiset"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestAddRemove(t *testing.T) {
+	m := multiset.New[string]()
+	test.True(t, m.IsEmpty())
+
+	test.Equal(t, m.Add("apple"), 1)
+	test.Equal(t, m.Add("apple"), 2)
+	test.Equal(t, m.AddN("orange", 3), 3)
+	test.Equal(t, m.AddN("orange", 0), 3)  // No-op
+	test.Equal(t, m.AddN("orange", -1), 3) // No-op
+
+	test.Equal(t, m.Size(), 5)
+	test.Equal(t, m.Distinct(), 2)
+	test.Equal(t, m.Count("apple"), 2)
+	test.Equal(t, m.Count("missing"), 0)
+
+	test.Equal(t, m.Remove("apple"), 1)
+	test.Equal(t, m.Remove("apple"), 0)
+	test.False(t, m.Contains("apple"))
+	test.Equal(t, m.Remove("apple"), 0) // Already gone, no-op
+
+	test.Equal(t, m.Size(), 3)
+
+	// Zero value should be usable
+	var zero multiset.Multiset[int]
+	zero.Add(1)
+	test.Equal(t, zero.Count(1), 1)
+}
+
+func TestAlgebra(t *testing.T) {
+	a := multiset.From([]string{"a", "a", "a", "b", "c"})
+	b := multiset.From([]string{"a", "b", "b", "d"})
+
+	union := multiset.Union(a, b)
+	test.True(t, multiset.Equal(union, multiset.From([]string{"a", "a", "a", "b", "b", "c", "d"})))
+
+	intersection := multiset.Intersection(a, b)
+	test.True(t, multiset.Equal(intersection, multiset.From([]string{"a", "b"})))
+
+	difference := multiset.Difference(a, b)
+	test.True(t, multiset.Equal(difference, multiset.From([]string{"a", "a", "c"})))
+
+	sum := multiset.Sum(a, b)
+	test.Equal(t, sum.Size(), a.Size()+b.Size())
+	test.Equal(t, sum.Count("a"), 4)
+
+	test.True(t, multiset.IsSubset(intersection, a))
+	test.True(t, multiset.IsSubset(intersection, b))
+	test.False(t, multiset.IsSubset(a, b))
+	test.True(t, multiset.IsSubset(multiset.From([]string{"a", "a"}), a))
+	test.False(t, multiset.IsSubset(multiset.From([]string{"b", "b"}), a))
+}
+
+func TestEqual(t *testing.T) {
+	a := multiset.From([]int{1, 1, 2})
+
+	test.False(t, multiset.Equal(a, nil))
+	test.True(t, multiset.Equal(a, multiset.From([]int{2, 1, 1})))
+	test.False(t, multiset.Equal(a, multiset.From([]int{1, 2, 2})))
+	test.False(t, multiset.Equal(a, multiset.From([]int{1, 2})))
+}
+
+func TestInterop(t *testing.T) {
+	c := counter.From([]string{"apple", "apple", "orange"})
+
+	m := multiset.FromCounter(c)
+	test.Equal(t, m.Count("apple"), 2)
+	test.Equal(t, m.Count("orange"), 1)
+
+	back := m.Counter()
+	test.Equal(t, back.Get("apple"), 2)
+	test.Equal(t, back.Sum(), 3)
+
+	distinct := m.Set()
+	test.EqualFunc(t, slices.Sorted(distinct.All()), []string{"apple", "orange"}, slices.Equal)
+
+	m.AddN("pear", 1_000_000_000_000)
+	test.Equal(t, m.Counter().Get("pear"), 1_000_000_000_000) // Large counts convert cheaply
+}
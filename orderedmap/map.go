@@ -3,8 +3,14 @@
 package orderedmap
 
 import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
 	"iter"
+	"slices"
 
+	"github.com/FollowTheProcess/collections/internal/jsonobject"
 	"github.com/FollowTheProcess/collections/list"
 )
 
@@ -94,6 +100,23 @@ func (m *Map[K, V]) Insert(key K, value V) (val V, existed bool) {
 	return value, false
 }
 
+// InsertBump is like [Map.Insert] but on an update it also moves the entry to the back
+// of the map, so it becomes the newest entry as reported by [Map.Newest].
+//
+// This makes the map suitable for backing a least recently used (LRU) cache, where
+// writing to a key should count as using it.
+func (m *Map[K, V]) InsertBump(key K, value V) (val V, existed bool) {
+	if old, exists := m.inner[key]; exists {
+		oldValue := old.value
+		old.value = value
+		m.list.MoveToBack(old.node)
+
+		return oldValue, true
+	}
+
+	return m.Insert(key, value)
+}
+
 // Remove removes a key from the map, returning the stored value and
 // a boolean to indicate whether it was in the map to begin with.
 //
@@ -113,6 +136,13 @@ func (m *Map[K, V]) Remove(key K) (value V, existed bool) {
 	return zero, false
 }
 
+// Clear removes all the key, value pairs from the map, retaining the
+// allocated memory of the backing map for reuse.
+func (m *Map[K, V]) Clear() {
+	clear(m.inner)
+	m.list = list.New[*entry[K, V]]()
+}
+
 // Size returns the number of items currently stored in the map. This operation
 // is O(1).
 func (m *Map[K, V]) Size() int {
@@ -151,6 +181,156 @@ func (m *Map[K, V]) Newest() (key K, value V, ok bool) {
 	return node.Item().key, node.Item().value, true
 }
 
+// Clone returns a new map containing the same key, value pairs in the same order, sharing
+// no internal storage with the original so modifying one has no effect on the other.
+//
+// The values themselves are copied by assignment, so a value containing pointers will still
+// refer to the same data.
+func (m *Map[K, V]) Clone() *Map[K, V] {
+	if m == nil {
+		return New[K, V]()
+	}
+
+	clone := WithCapacity[K, V](len(m.inner))
+	for key, value := range m.All() {
+		clone.Insert(key, value)
+	}
+
+	return clone
+}
+
+// MoveToFront moves the entry for key to the front of the map, so it becomes
+// the oldest, returning whether the key existed.
+//
+// Only the insertion order is changed, the stored value is untouched.
+func (m *Map[K, V]) MoveToFront(key K) bool {
+	entry, exists := m.inner[key]
+	if !exists {
+		return false
+	}
+
+	m.list.MoveToFront(entry.node)
+
+	return true
+}
+
+// MoveToBack moves the entry for key to the back of the map, so it becomes
+// the newest, returning whether the key existed.
+//
+// Only the insertion order is changed, the stored value is untouched.
+func (m *Map[K, V]) MoveToBack(key K) bool {
+	entry, exists := m.inner[key]
+	if !exists {
+		return false
+	}
+
+	m.list.MoveToBack(entry.node)
+
+	return true
+}
+
+// PopOldest removes and returns the oldest key, value pair in the map, i.e. the
+// pair that was inserted first.
+//
+// If the map is empty, the zero values and false are returned.
+func (m *Map[K, V]) PopOldest() (key K, value V, ok bool) {
+	node, err := m.list.PopFirst()
+	if err != nil {
+		// Empty list
+		return key, value, false
+	}
+
+	delete(m.inner, node.Item().key)
+
+	return node.Item().key, node.Item().value, true
+}
+
+// PopNewest removes and returns the newest key, value pair in the map, i.e. the
+// pair that was inserted last.
+//
+// If the map is empty, the zero values and false are returned.
+func (m *Map[K, V]) PopNewest() (key K, value V, ok bool) {
+	node, err := m.list.Pop()
+	if err != nil {
+		// Empty list
+		return key, value, false
+	}
+
+	delete(m.inner, node.Item().key)
+
+	return node.Item().key, node.Item().value, true
+}
+
+// At returns the key, value pair at the given position in the insertion order, where 0
+// is the oldest. Negative indices count back from the newest, so -1 is the newest.
+//
+// If index is out of range, the zero values and false are returned. The map is
+// walked from whichever end is nearer, but this is still O(n).
+func (m *Map[K, V]) At(index int) (key K, value V, ok bool) {
+	size := m.Size()
+	if index < 0 {
+		index += size
+	}
+
+	if index < 0 || index >= size {
+		return key, value, false
+	}
+
+	// Walk from whichever end is closer
+	entries, steps := m.All(), index
+	if index >= size/2 { //nolint: mnd // Halfway, 2 is not magic
+		entries, steps = m.Backwards(), size-1-index
+	}
+
+	for key, value = range entries {
+		if steps == 0 {
+			break
+		}
+
+		steps--
+	}
+
+	return key, value, true
+}
+
+// SortFunc reorders the map so that iterating over it yields the keys in the order
+// given by compare, rather than insertion order. Subsequent insertions are still
+// added at the end.
+//
+// compare should return a negative number when a < b, a positive number when
+// a > b and zero when a == b, as in [slices.SortFunc]. The sort is stable, so keys
+// that compare equal keep their relative order. Only the order changes, the keys
+// and values are untouched.
+func (m *Map[K, V]) SortFunc(compare func(a, b K) int) {
+	entries := slices.Collect(m.list.All())
+	slices.SortStableFunc(entries, func(a, b *entry[K, V]) int {
+		return compare(a.key, b.key)
+	})
+
+	// Moving each to the back in turn leaves them in sorted order, reusing the nodes
+	for _, e := range entries {
+		m.list.MoveToBack(e.node)
+	}
+}
+
+// Merge inserts all the entries from other into the map, in other's insertion order,
+// following the semantics of [Map.Insert]:
+//
+//   - A key already in the map has its value updated but keeps its current position
+//   - A key not yet in the map is added at the end
+//
+// So for layered configuration, the map ends up with values from other, but keys
+// ordered by where they were first defined. Merging a nil map is a no-op.
+func (m *Map[K, V]) Merge(other *Map[K, V]) {
+	if other == nil {
+		return
+	}
+
+	for key, value := range other.All() {
+		m.Insert(key, value)
+	}
+}
+
 // GetOrInsert fetches a value by it's key if it is present in the map, and if not
 // inserts the passed in value against that key instead.
 //
@@ -173,6 +353,54 @@ func (m *Map[K, V]) GetOrInsert(key K, value V) (val V, existed bool) {
 	return value, false
 }
 
+// MarshalJSON implements [json.Marshaler] for a [Map], encoding it as a JSON object
+// with the keys in insertion order. A nil map is encoded as null.
+//
+// Keys follow the same rules as [encoding/json] does for map keys: they must be strings,
+// integers, or implement [encoding.TextMarshaler], any other key type returns an error.
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	return jsonobject.Encode(m.All())
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] for a [Map], decoding a JSON object and
+// inserting its entries in the order the keys appear in data.
+//
+// Keys follow the same rules as [encoding/json] does for map keys: they must be strings,
+// integers, or implement [encoding.TextUnmarshaler], any other key type returns an error. If
+// a key appears more than once, the last value wins but the key keeps the position it was first
+// seen in. As with encoding/json, decoding null is a no-op.
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	// nil safety
+	if m.inner == nil {
+		m.inner = make(map[K]*entry[K, V])
+		m.list = list.New[*entry[K, V]]()
+	}
+
+	err := jsonobject.Decode(data, func(key K, dec *json.Decoder) error {
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		m.Insert(key, value)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not decode ordered map: %w", err)
+	}
+
+	return nil
+}
+
 // All returns an iterator over the entries in the map
 // in the order in which they were inserted.
 func (m *Map[K, V]) All() iter.Seq2[K, V] {
@@ -185,6 +413,18 @@ func (m *Map[K, V]) All() iter.Seq2[K, V] {
 	}
 }
 
+// Backwards returns an iterator over the entries in the map in the reverse
+// of the order in which they were inserted, i.e. newest first.
+func (m *Map[K, V]) Backwards() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for item := range m.list.Backwards() {
+			if !yield(item.key, item.value) {
+				return
+			}
+		}
+	}
+}
+
 // Keys returns an iterator over the keys in the map
 // in the order in which they were inserted.
 func (m *Map[K, V]) Keys() iter.Seq[K] {
@@ -208,3 +448,90 @@ func (m *Map[K, V]) Values() iter.Seq[V] {
 		}
 	}
 }
+
+// KeysSlice returns the keys in the map as a slice, in insertion order.
+//
+// It is equivalent to slices.Collect(m.Keys()) but allocates the slice up front.
+func (m *Map[K, V]) KeysSlice() []K {
+	keys := make([]K, 0, m.Size())
+	for item := range m.list.All() {
+		keys = append(keys, item.key)
+	}
+
+	return keys
+}
+
+// ValuesSlice returns the values in the map as a slice, in insertion order.
+//
+// It is equivalent to slices.Collect(m.Values()) but allocates the slice up front.
+func (m *Map[K, V]) ValuesSlice() []V {
+	values := make([]V, 0, m.Size())
+	for item := range m.list.All() {
+		values = append(values, item.value)
+	}
+
+	return values
+}
+
+// Sort reorders the map so that iterating over it yields the keys in ascending
+// order, rather than insertion order. Subsequent insertions are still added at the end.
+//
+// It is a convenience for [Map.SortFunc] with [cmp.Compare] for maps with ordered keys.
+func Sort[K cmp.Ordered, V any](m *Map[K, V]) {
+	m.SortFunc(cmp.Compare[K])
+}
+
+// Invert returns a new map with the keys and values of m swapped, preserving the
+// insertion order of m so the first value inserted into m becomes the first key.
+//
+// If more than one key in m maps to the same value, the later key wins, following
+// the normal [Map.Insert] semantics: the entry keeps the position of the first
+// occurrence but takes the key of the last.
+//
+//	m := orderedmap.New[int, string]()
+//	m.Insert(1, "a")
+//	m.Insert(2, "b")
+//	m.Insert(3, "a")
+//	inv := orderedmap.Invert(m) // "a" -> 3, "b" -> 2
+func Invert[K, V comparable](m *Map[K, V]) *Map[V, K] {
+	inverted := WithCapacity[V, K](m.Size())
+	for key, value := range m.All() {
+		inverted.Insert(value, key)
+	}
+
+	return inverted
+}
+
+// Equal returns whether two maps are equal to one another, i.e. they are exactly the same
+// size and contain exactly the same key, value pairs in the same order.
+//
+// If either of the two maps are nil, Equal returns false.
+func Equal[K, V comparable](a, b *Map[K, V]) bool {
+	return EqualFunc(a, b, func(x, y V) bool { return x == y })
+}
+
+// EqualFunc is like [Equal] but uses eq to compare values, so may be used
+// for maps whose values are not comparable.
+//
+// If either of the two maps are nil, EqualFunc returns false.
+func EqualFunc[K comparable, V any](a, b *Map[K, V], eq func(x, y V) bool) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	if a.Size() != b.Size() {
+		return false
+	}
+
+	next, stop := iter.Pull2(b.All())
+	defer stop()
+
+	for keyA, valueA := range a.All() {
+		keyB, valueB, _ := next()
+		if keyA != keyB || !eq(valueA, valueB) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"encoding/json"
 	"maps"
 	"slices"
 	"testing"
@@ -101,6 +102,277 @@ func TestNewest(t *testing.T) {
 	test.Equal(t, newestValue, "four") // Wrong newest value
 }
 
+func TestMove(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	m.Insert("one", 1)
+	m.Insert("two", 2)
+	m.Insert("three", 3)
+
+	test.True(t, m.MoveToBack("one"))
+
+	key, value, ok := m.Newest()
+	test.True(t, ok)
+	test.Equal(t, key, "one")
+	test.Equal(t, value, 1)
+
+	test.True(t, m.MoveToFront("three"))
+
+	key, _, ok = m.Oldest()
+	test.True(t, ok)
+	test.Equal(t, key, "three")
+
+	test.EqualFunc(t, slices.Collect(m.Keys()), []string{"three", "two", "one"}, slices.Equal)
+
+	test.False(t, m.MoveToFront("missing"))
+	test.False(t, m.MoveToBack("missing"))
+	test.Equal(t, m.Size(), 3)
+}
+
+func TestPopOldestNewest(t *testing.T) {
+	m := orderedmap.New[string, int]()
+
+	_, _, ok := m.PopOldest()
+	test.False(t, ok)
+
+	_, _, ok = m.PopNewest()
+	test.False(t, ok)
+
+	m.Insert("one", 1)
+	m.Insert("two", 2)
+	m.Insert("three", 3)
+
+	key, value, ok := m.PopOldest()
+	test.True(t, ok)
+	test.Equal(t, key, "one")
+	test.Equal(t, value, 1)
+	test.False(t, m.Contains("one"))
+
+	key, value, ok = m.PopNewest()
+	test.True(t, ok)
+	test.Equal(t, key, "three")
+	test.Equal(t, value, 3)
+	test.False(t, m.Contains("three"))
+
+	test.Equal(t, m.Size(), 1)
+	test.EqualFunc(t, slices.Collect(m.Keys()), []string{"two"}, slices.Equal)
+}
+
+func TestMarshalJSON(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	m.Insert("zebra", 1)
+	m.Insert("apple", 2)
+	m.Insert("mango", 3)
+
+	data, err := json.Marshal(m)
+	test.Ok(t, err)
+	test.Equal(t, string(data), `{"zebra":1,"apple":2,"mango":3}`) // Insertion order, not sorted
+
+	m.MoveToBack("zebra")
+
+	data, err = json.Marshal(m)
+	test.Ok(t, err)
+	test.Equal(t, string(data), `{"apple":2,"mango":3,"zebra":1}`)
+
+	empty, err := json.Marshal(orderedmap.New[string, []string]())
+	test.Ok(t, err)
+	test.Equal(t, string(empty), `{}`)
+
+	var nilMap *orderedmap.Map[string, int]
+
+	null, err := nilMap.MarshalJSON()
+	test.Ok(t, err)
+	test.Equal(t, string(null), `null`)
+
+	ints := orderedmap.New[int, int]()
+	ints.Insert(2, 2)
+	ints.Insert(1, 1)
+
+	data, err = json.Marshal(ints)
+	test.Ok(t, err)
+	test.Equal(t, string(data), `{"2":2,"1":1}`) // Int keys are quoted, like encoding/json
+
+	floats := orderedmap.New[float64, int]()
+	floats.Insert(1.5, 1)
+
+	_, err = json.Marshal(floats)
+	test.Err(t, err) // Unsupported key type
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	test.Ok(t, json.Unmarshal([]byte(`{"zebra": 1, "apple": 2, "mango": 3, "apple": 22}`), m))
+
+	test.EqualFunc(t, slices.Collect(m.Keys()), []string{"zebra", "apple", "mango"}, slices.Equal)
+	test.EqualFunc(t, slices.Collect(m.Values()), []int{1, 22, 3}, slices.Equal)
+
+	// Round trip
+	data, err := json.Marshal(m)
+	test.Ok(t, err)
+	test.Equal(t, string(data), `{"zebra":1,"apple":22,"mango":3}`)
+
+	// As part of a larger struct, with a nil map
+	type config struct {
+		Steps *orderedmap.Map[string, []string] `json:"steps"`
+	}
+
+	var cfg config
+	test.Ok(t, json.Unmarshal([]byte(`{"steps": {"build": ["go", "build"], "test": ["go", "test"]}}`), &cfg))
+	test.EqualFunc(t, slices.Collect(cfg.Steps.Keys()), []string{"build", "test"}, slices.Equal)
+
+	tests := []struct {
+		name string // Name of the test case
+		data string // The JSON to decode
+	}{
+		{name: "array", data: `[1, 2, 3]`},
+		{name: "bad value", data: `{"one": "not an int"}`},
+		{name: "truncated", data: `{"one": 1`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.Err(t, json.Unmarshal([]byte(tt.data), orderedmap.New[string, int]()))
+		})
+	}
+
+	ints := orderedmap.New[int, int]()
+	test.Ok(t, json.Unmarshal([]byte(`{"2": 2, "1": 1}`), ints))
+	test.EqualFunc(t, ints.KeysSlice(), []int{2, 1}, slices.Equal) // Int keys, like encoding/json
+
+	test.Err(t, json.Unmarshal([]byte(`{"1.5": 1}`), orderedmap.New[float64, int]())) // Unsupported keys
+
+	// null is a no-op, whether called directly or through encoding/json
+	existing := orderedmap.New[string, int]()
+	existing.Insert("one", 1)
+	test.Ok(t, existing.UnmarshalJSON([]byte("null")))
+	test.EqualFunc(t, existing.KeysSlice(), []string{"one"}, slices.Equal)
+
+	var nullCfg config
+	test.Ok(t, json.Unmarshal([]byte(`{"steps": null}`), &nullCfg))
+	test.True(t, nullCfg.Steps == nil)
+}
+
+func TestClone(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	m.Insert("one", 1)
+	m.Insert("two", 2)
+	m.Insert("three", 3)
+
+	clone := m.Clone()
+	test.EqualFunc(t, slices.Collect(clone.Keys()), []string{"one", "two", "three"}, slices.Equal)
+	test.EqualFunc(t, slices.Collect(clone.Values()), []int{1, 2, 3}, slices.Equal)
+
+	// Mutating the clone should not affect the original
+	clone.MoveToFront("three")
+	clone.Remove("one")
+	clone.Insert("two", 22)
+
+	test.EqualFunc(t, slices.Collect(m.Keys()), []string{"one", "two", "three"}, slices.Equal)
+	test.EqualFunc(t, slices.Collect(m.Values()), []int{1, 2, 3}, slices.Equal)
+
+	var nilMap *orderedmap.Map[string, int]
+	test.Equal(t, nilMap.Clone().Size(), 0)
+}
+
+func TestClear(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	m.Insert("one", 1)
+	m.Insert("two", 2)
+
+	m.Clear()
+	test.Equal(t, m.Size(), 0)
+	test.False(t, m.Contains("one"))
+
+	_, _, ok := m.Oldest()
+	test.False(t, ok)
+
+	_, _, ok = m.Newest()
+	test.False(t, ok)
+
+	// Should be reusable
+	m.Insert("three", 3)
+	test.EqualFunc(t, slices.Collect(m.Keys()), []string{"three"}, slices.Equal)
+}
+
+func TestAt(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	m.Insert("one", 1)
+	m.Insert("two", 2)
+	m.Insert("three", 3)
+	m.Insert("four", 4)
+	m.Insert("five", 5)
+
+	tests := []struct {
+		name  string // Name of the test case
+		key   string // Expected key
+		index int    // Index to pass to At
+		value int    // Expected value
+		ok    bool   // Expected ok
+	}{
+		{name: "first", index: 0, key: "one", value: 1, ok: true},
+		{name: "second", index: 1, key: "two", value: 2, ok: true},
+		{name: "middle", index: 2, key: "three", value: 3, ok: true},
+		{name: "last", index: 4, key: "five", value: 5, ok: true},
+		{name: "negative last", index: -1, key: "five", value: 5, ok: true},
+		{name: "negative first", index: -5, key: "one", value: 1, ok: true},
+		{name: "out of range", index: 5, ok: false},
+		{name: "negative out of range", index: -6, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, value, ok := m.At(tt.index)
+			test.Equal(t, ok, tt.ok)
+			test.Equal(t, key, tt.key)
+			test.Equal(t, value, tt.value)
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	m.Insert("zebra", 1)
+	m.Insert("apple", 2)
+	m.Insert("mango", 3)
+
+	orderedmap.Sort(m)
+	test.EqualFunc(t, slices.Collect(m.Keys()), []string{"apple", "mango", "zebra"}, slices.Equal)
+	test.EqualFunc(t, slices.Collect(m.Values()), []int{2, 3, 1}, slices.Equal)
+
+	// Sort by length descending, ties keep their current relative order
+	m.Insert("fig", 4)
+	m.SortFunc(func(a, b string) int { return len(b) - len(a) })
+	test.EqualFunc(t, slices.Collect(m.Keys()), []string{"apple", "mango", "zebra", "fig"}, slices.Equal)
+
+	value, ok := m.Get("zebra")
+	test.True(t, ok)
+	test.Equal(t, value, 1)
+
+	// Oldest and Newest follow the new order
+	oldest, _, _ := m.Oldest()
+	test.Equal(t, oldest, "apple")
+
+	// Sorting an empty map is fine
+	orderedmap.Sort(orderedmap.New[string, int]())
+}
+
+func TestMerge(t *testing.T) {
+	base := orderedmap.New[string, string]()
+	base.Insert("name", "base")
+	base.Insert("level", "info")
+
+	overrides := orderedmap.New[string, string]()
+	overrides.Insert("output", "stdout")
+	overrides.Insert("level", "debug")
+
+	base.Merge(overrides)
+	base.Merge(nil) // No-op
+
+	test.EqualFunc(t, slices.Collect(base.Keys()), []string{"name", "level", "output"}, slices.Equal)
+	test.EqualFunc(t, slices.Collect(base.Values()), []string{"base", "debug", "stdout"}, slices.Equal)
+
+	test.Equal(t, overrides.Size(), 2) // Other untouched
+}
+
 func TestGetOrInsert(t *testing.T) {
 	m := orderedmap.New[string, int]()
 
@@ -148,6 +420,34 @@ func TestItems(t *testing.T) {
 	test.EqualFunc(t, items, want, maps.Equal)
 }
 
+func TestBackwards(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	m.Insert("one", 1)
+	m.Insert("two", 2)
+	m.Insert("three", 3)
+
+	var keys []string
+
+	var values []int
+
+	for key, value := range m.Backwards() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	test.EqualFunc(t, keys, []string{"three", "two", "one"}, slices.Equal)
+	test.EqualFunc(t, values, []int{3, 2, 1}, slices.Equal)
+
+	// Early return
+	keys = nil
+	for key := range m.Backwards() {
+		keys = append(keys, key)
+		break
+	}
+
+	test.EqualFunc(t, keys, []string{"three"}, slices.Equal)
+}
+
 func TestKeys(t *testing.T) {
 	m := orderedmap.New[string, int]()
 	m.Insert("one", 1)
@@ -222,3 +522,127 @@ func BenchmarkRemove(b *testing.B) {
 		}
 	})
 }
+
+func TestEqual(t *testing.T) {
+	build := func(keys ...string) *orderedmap.Map[string, int] {
+		m := orderedmap.New[string, int]()
+		for i, key := range keys {
+			m.Insert(key, i)
+		}
+
+		return m
+	}
+
+	tests := []struct {
+		a, b *orderedmap.Map[string, int] // Maps to compare
+		name string                       // Name of the test case
+		want bool                         // Whether they should be considered equal
+	}{
+		{
+			name: "nil",
+			a:    nil,
+			b:    nil,
+			want: false,
+		},
+		{
+			name: "one nil",
+			a:    build(),
+			b:    nil,
+			want: false,
+		},
+		{
+			name: "empty",
+			a:    build(),
+			b:    build(),
+			want: true,
+		},
+		{
+			name: "same",
+			a:    build("one", "two"),
+			b:    build("one", "two"),
+			want: true,
+		},
+		{
+			name: "different order",
+			a:    build("one", "two"),
+			b:    build("two", "one"),
+			want: false,
+		},
+		{
+			name: "different size",
+			a:    build("one", "two"),
+			b:    build("one", "two", "three"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.Equal(t, orderedmap.Equal(tt.a, tt.b), tt.want)
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	a := orderedmap.New[string, []int]()
+	a.Insert("one", []int{1})
+	a.Insert("two", []int{2, 2})
+
+	b := a.Clone()
+	test.True(t, orderedmap.EqualFunc(a, b, slices.Equal))
+
+	b.Insert("two", []int{2})
+	test.False(t, orderedmap.EqualFunc(a, b, slices.Equal))
+}
+
+func TestKeysValuesSlice(t *testing.T) {
+	m := orderedmap.New[string, int]()
+	test.Equal(t, len(m.KeysSlice()), 0)
+	test.Equal(t, len(m.ValuesSlice()), 0)
+
+	m.Insert("one", 1)
+	m.Insert("two", 2)
+	m.Insert("three", 3)
+
+	test.EqualFunc(t, m.KeysSlice(), []string{"one", "two", "three"}, slices.Equal)
+	test.EqualFunc(t, m.ValuesSlice(), []int{1, 2, 3}, slices.Equal)
+}
+
+func TestInvert(t *testing.T) {
+	m := orderedmap.New[int, string]()
+	m.Insert(1, "a")
+	m.Insert(2, "b")
+	m.Insert(3, "a")
+
+	inverted := orderedmap.Invert(m)
+
+	test.Equal(t, inverted.Size(), 2)
+	test.EqualFunc(t, inverted.KeysSlice(), []string{"a", "b"}, slices.Equal)
+	test.EqualFunc(t, inverted.ValuesSlice(), []int{3, 2}, slices.Equal)
+
+	// Original is untouched
+	test.Equal(t, m.Size(), 3)
+}
+
+func TestInsertBump(t *testing.T) {
+	m := orderedmap.New[string, int]()
+
+	val, existed := m.InsertBump("one", 1)
+	test.Equal(t, val, 1)
+	test.False(t, existed)
+
+	m.InsertBump("two", 2)
+	m.InsertBump("three", 3)
+
+	val, existed = m.InsertBump("one", 10)
+	test.Equal(t, val, 1)
+	test.True(t, existed)
+
+	key, value, ok := m.Newest()
+	test.True(t, ok)
+	test.Equal(t, key, "one")
+	test.Equal(t, value, 10)
+
+	test.EqualFunc(t, m.KeysSlice(), []string{"two", "three", "one"}, slices.Equal)
+	test.Equal(t, m.Size(), 3)
+}
@@ -0,0 +1,85 @@
+package orderedmap
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncMap is an ordered [Map] that is safe for concurrent access across goroutines.
+//
+// Reads take a shared read lock and writes take an exclusive write lock. A SyncMap
+// must be created with [NewSync].
+type SyncMap[K comparable, V any] struct {
+	m  *Map[K, V]   // The wrapped map
+	mu sync.RWMutex // Guards m
+}
+
+// NewSync creates and returns a new empty [SyncMap].
+func NewSync[K comparable, V any]() *SyncMap[K, V] {
+	return &SyncMap[K, V]{m: New[K, V]()}
+}
+
+// Get returns the value stored against the given key in the map and a boolean
+// to indicate presence, like the standard Go map.
+func (s *SyncMap[K, V]) Get(key K) (value V, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.Get(key)
+}
+
+// Contains reports whether the map contains the given key.
+func (s *SyncMap[K, V]) Contains(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.Contains(key)
+}
+
+// Insert inserts a new value into the map against the given key, returning the previous
+// value and a boolean to indicate presence, see [Map.Insert].
+func (s *SyncMap[K, V]) Insert(key K, value V) (val V, existed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.m.Insert(key, value)
+}
+
+// Remove removes a key from the map, returning the stored value and
+// a boolean to indicate whether it was in the map to begin with.
+func (s *SyncMap[K, V]) Remove(key K) (value V, existed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.m.Remove(key)
+}
+
+// Size returns the number of items currently stored in the map.
+func (s *SyncMap[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.m.Size()
+}
+
+// All returns an iterator over a snapshot of the entries in the map, in the order
+// in which they were inserted at the time All is called.
+//
+// The lock is not held during iteration, so the map may be freely modified
+// while iterating, but those modifications will not be reflected in the iterator.
+func (s *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	s.mu.RLock()
+	snapshot := make([]entry[K, V], 0, s.m.Size())
+	for key, value := range s.m.All() {
+		snapshot = append(snapshot, entry[K, V]{key: key, value: value})
+	}
+	s.mu.RUnlock()
+
+	return func(yield func(K, V) bool) {
+		for _, e := range snapshot {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
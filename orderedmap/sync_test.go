@@ -0,0 +1,76 @@
+package orderedmap_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/orderedmap"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestSyncMap(t *testing.T) {
+	m := orderedmap.NewSync[string, int]()
+	test.Equal(t, m.Size(), 0)
+
+	_, existed := m.Insert("one", 1)
+	test.False(t, existed)
+
+	old, existed := m.Insert("one", 11)
+	test.True(t, existed)
+	test.Equal(t, old, 1)
+
+	m.Insert("two", 2)
+
+	value, ok := m.Get("one")
+	test.True(t, ok)
+	test.Equal(t, value, 11)
+	test.True(t, m.Contains("two"))
+
+	removed, existed := m.Remove("two")
+	test.True(t, existed)
+	test.Equal(t, removed, 2)
+	test.Equal(t, m.Size(), 1)
+}
+
+func TestSyncMapAll(t *testing.T) {
+	m := orderedmap.NewSync[string, int]()
+	m.Insert("c", 3)
+	m.Insert("a", 1)
+	m.Insert("b", 2)
+
+	var keys []string
+
+	for key := range m.All() {
+		keys = append(keys, key)
+		m.Insert(key+key, 0) // Must not deadlock or appear in the snapshot
+	}
+
+	test.EqualFunc(t, keys, []string{"c", "a", "b"}, slices.Equal)
+	test.Equal(t, m.Size(), 6)
+}
+
+func TestSyncMapConcurrent(t *testing.T) {
+	m := orderedmap.NewSync[int, int]()
+
+	var wg sync.WaitGroup
+
+	for i := range 100 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			m.Insert(i, i)
+			m.Get(i)
+
+			for range m.All() {
+				break
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	test.Equal(t, m.Size(), 100)
+}
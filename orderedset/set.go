@@ -0,0 +1,180 @@
+// Package orderedset implements an ordered set, that is; a set that remembers the order in which
+// items were inserted.
+//
+// As well as set operations, the items may be accessed by their position, so an ordered set
+// doubles as a sequence without duplicates.
+//
+// The set is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package orderedset
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Set is an insertion ordered set.
+type Set[T comparable] struct {
+	positions map[T]int // The backing hashmap of item -> index in items
+	items     []T       // The items in insertion order
+}
+
+// New builds and returns a new empty ordered [Set].
+func New[T comparable]() *Set[T] {
+	return &Set[T]{
+		positions: make(map[T]int),
+	}
+}
+
+// WithCapacity builds and returns a new ordered [Set] with the given capacity.
+//
+// This can be a useful performance improvement when the expected maximum size of the set
+// is known ahead of time as it eliminates the need for reallocation.
+func WithCapacity[T comparable](capacity int) *Set[T] {
+	return &Set[T]{
+		positions: make(map[T]int, capacity),
+		items:     make([]T, 0, capacity),
+	}
+}
+
+// From builds an ordered [Set] from an existing slice of items, inserting them
+// in the order of the slice.
+//
+// Duplicates keep the position of their first occurrence.
+func From[T comparable](items []T) *Set[T] {
+	set := WithCapacity[T](len(items))
+	for _, item := range items {
+		set.Insert(item)
+	}
+
+	return set
+}
+
+// Collect builds an ordered [Set] from an iterator of items, inserting them
+// in the order of iteration.
+//
+// Duplicates keep the position of their first occurrence.
+func Collect[T comparable](items iter.Seq[T]) *Set[T] {
+	set := New[T]()
+	for item := range items {
+		set.Insert(item)
+	}
+
+	return set
+}
+
+// Insert inserts an item at the end of the [Set].
+//
+// Returns whether the item was newly inserted. Inserting an item that
+// is already present is a no-op and does not change its position.
+//
+//	s := orderedset.New[string]()
+//	s.Insert("foo") // true -> set was modified by the insertion
+//	s.Insert("foo") // false -> "foo" is already in the set, it was not modified
+func (s *Set[T]) Insert(item T) bool {
+	if _, exists := s.positions[item]; exists {
+		return false
+	}
+
+	// nil safety
+	if s.positions == nil {
+		s.positions = make(map[T]int)
+	}
+
+	s.positions[item] = len(s.items)
+	s.items = append(s.items, item)
+
+	return true
+}
+
+// Contains reports whether the set contains item.
+func (s *Set[T]) Contains(item T) bool {
+	_, exists := s.positions[item]
+
+	return exists
+}
+
+// Remove removes an item from the set.
+//
+// Returns whether the value was present. Removing an item
+// that wasn't in the set is a no-op.
+//
+// The items after the removed one are shifted down to keep the set contiguous,
+// so Remove is O(n).
+func (s *Set[T]) Remove(item T) bool {
+	index, exists := s.positions[item]
+	if !exists {
+		return false
+	}
+
+	delete(s.positions, item)
+	s.items = slices.Delete(s.items, index, index+1)
+
+	// Everything after index has moved down one
+	for i := index; i < len(s.items); i++ {
+		s.positions[s.items[i]] = i
+	}
+
+	return true
+}
+
+// At returns the item at the given position in the set, where 0 is the oldest item.
+//
+// If index is out of range, the zero value and false are returned.
+func (s *Set[T]) At(index int) (item T, ok bool) {
+	if index < 0 || index >= len(s.items) {
+		return item, false
+	}
+
+	return s.items[index], true
+}
+
+// Index returns the position of item in the set, where 0 is the oldest item, or
+// -1 if it is not present.
+func (s *Set[T]) Index(item T) int {
+	index, exists := s.positions[item]
+	if !exists {
+		return -1
+	}
+
+	return index
+}
+
+// Size returns the number of items currently in the set.
+func (s *Set[T]) Size() int {
+	return len(s.items)
+}
+
+// IsEmpty reports whether the set is empty.
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Oldest returns the oldest item in the set, i.e. the one that was
+// inserted first.
+//
+// If the set is empty, the zero value and false are returned.
+func (s *Set[T]) Oldest() (item T, ok bool) {
+	return s.At(0)
+}
+
+// Newest returns the newest item in the set, i.e. the one that was
+// inserted last.
+//
+// If the set is empty, the zero value and false are returned.
+func (s *Set[T]) Newest() (item T, ok bool) {
+	return s.At(len(s.items) - 1)
+}
+
+// All returns an iterator over the items in the set in the order
+// in which they were inserted.
+func (s *Set[T]) All() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// String implements [fmt.Stringer] for an ordered [Set] and allows
+// it to print itself.
+func (s *Set[T]) String() string {
+	return fmt.Sprintf("%v", s.items)
+}
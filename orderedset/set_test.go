@@ -0,0 +1,120 @@
+package orderedset_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/orderedset"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestInsert(t *testing.T) {
+	s := orderedset.New[string]()
+	test.True(t, s.IsEmpty())
+
+	test.True(t, s.Insert("foo"))
+	test.False(t, s.Insert("foo")) // Already present
+	test.True(t, s.Insert("bar"))
+
+	test.True(t, s.Contains("foo"))
+	test.False(t, s.Contains("baz"))
+	test.Equal(t, s.Size(), 2)
+
+	// testing nil safety
+	danger := &orderedset.Set[string]{}
+	test.True(t, danger.Insert("bar"))
+	test.False(t, danger.Insert("bar"))
+}
+
+func TestRemove(t *testing.T) {
+	s := orderedset.From([]string{"one", "two", "three"})
+
+	test.True(t, s.Remove("two"))
+	test.False(t, s.Remove("two")) // Already gone
+	test.False(t, s.Contains("two"))
+	test.Equal(t, s.Size(), 2)
+
+	test.EqualFunc(t, slices.Collect(s.All()), []string{"one", "three"}, slices.Equal)
+}
+
+func TestOrder(t *testing.T) {
+	s := orderedset.From([]string{"c", "a", "b", "a", "c", "d"})
+
+	test.EqualFunc(t, slices.Collect(s.All()), []string{"c", "a", "b", "d"}, slices.Equal)
+	test.Equal(t, s.String(), "[c a b d]")
+
+	// Removing and re-inserting moves the item to the end
+	s.Remove("c")
+	s.Insert("c")
+	test.EqualFunc(t, slices.Collect(s.All()), []string{"a", "b", "d", "c"}, slices.Equal)
+}
+
+func TestAtIndex(t *testing.T) {
+	s := orderedset.From([]string{"one", "two", "three", "four"})
+
+	item, ok := s.At(1)
+	test.True(t, ok)
+	test.Equal(t, item, "two")
+
+	_, ok = s.At(4)
+	test.False(t, ok)
+
+	_, ok = s.At(-1)
+	test.False(t, ok)
+
+	test.Equal(t, s.Index("three"), 2)
+	test.Equal(t, s.Index("five"), -1)
+
+	// Removal shifts everything after it down
+	s.Remove("two")
+	test.Equal(t, s.Index("three"), 1)
+	test.Equal(t, s.Index("four"), 2)
+
+	item, ok = s.At(1)
+	test.True(t, ok)
+	test.Equal(t, item, "three")
+}
+
+func TestOldestNewest(t *testing.T) {
+	s := orderedset.New[int]()
+
+	_, ok := s.Oldest()
+	test.False(t, ok)
+
+	_, ok = s.Newest()
+	test.False(t, ok)
+
+	s.Insert(1)
+	s.Insert(2)
+	s.Insert(3)
+
+	oldest, ok := s.Oldest()
+	test.True(t, ok)
+	test.Equal(t, oldest, 1)
+
+	newest, ok := s.Newest()
+	test.True(t, ok)
+	test.Equal(t, newest, 3)
+
+	// Zero value should be safe too
+	var zero orderedset.Set[int]
+
+	_, ok = zero.Oldest()
+	test.False(t, ok)
+	test.Equal(t, len(slices.Collect(zero.All())), 0)
+}
+
+func TestCollect(t *testing.T) {
+	s := orderedset.Collect(slices.Values([]string{"b", "a", "b"}))
+	test.EqualFunc(t, slices.Collect(s.All()), []string{"b", "a"}, slices.Equal)
+}
+
+func BenchmarkInsert(b *testing.B) {
+	s := orderedset.New[int]()
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		s.Insert(i)
+	}
+}
@@ -3,7 +3,9 @@
 package priority
 
 import (
+	"cmp"
 	"errors"
+	"slices"
 )
 
 // Element holds an element in the priority queue along with it's priority.
@@ -115,6 +117,20 @@ func (q *Queue[T]) IsEmpty() bool {
 	return len(q.container) == 0
 }
 
+// Elements returns a copy of all the elements currently in the queue, sorted in
+// descending order of priority (i.e. the order in which they would be popped).
+//
+// The queue itself is not modified, making this useful for inspecting or logging
+// the full state of the queue without draining it.
+func (q *Queue[T]) Elements() []Element[T] {
+	elements := slices.Clone(q.container)
+	slices.SortStableFunc(elements, func(a, b Element[T]) int {
+		return cmp.Compare(b.Priority, a.Priority)
+	})
+
+	return elements
+}
+
 // init heapifies the underlying container, establishing the heap invariants required by
 // the other methods. It is only used when creating a priority queue with [From].
 func (q *Queue[T]) init() {
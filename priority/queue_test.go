@@ -1,6 +1,7 @@
 package priority_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/FollowTheProcess/collections/priority"
@@ -17,6 +18,21 @@ func TestNew(t *testing.T) {
 	test.Equal(t, item, "")
 }
 
+func TestWithCapacity(t *testing.T) {
+	q := priority.WithCapacity[string](10)
+	test.Equal(t, q.Size(), 0)       // Initial size should be empty
+	test.Equal(t, q.IsEmpty(), true) // Should be empty
+
+	q.Push("one", 1)
+	q.Push("two", 2)
+
+	test.Equal(t, q.Size(), 2) // Wrong size after push
+
+	item, err := q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "two")
+}
+
 func TestFrom(t *testing.T) {
 	items := []priority.Element[string]{
 		{Item: "six", Priority: 6},
@@ -114,6 +130,31 @@ func TestPushPop(t *testing.T) {
 	test.Equal(t, fifth, "")
 }
 
+func TestElements(t *testing.T) {
+	q := priority.New[string]()
+	test.Equal(t, len(q.Elements()), 0) // Empty queue should have no elements
+
+	q.Push("two", 2)
+	q.Push("one", 1)
+	q.Push("four", 4)
+	q.Push("three", 3)
+
+	want := []priority.Element[string]{
+		{Item: "four", Priority: 4},
+		{Item: "three", Priority: 3},
+		{Item: "two", Priority: 2},
+		{Item: "one", Priority: 1},
+	}
+
+	test.EqualFunc(t, q.Elements(), want, slices.Equal)
+
+	test.Equal(t, q.Size(), 4) // Elements should not modify the queue
+
+	item, err := q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "four") // Heap order should be undisturbed
+}
+
 // BenchmarkNew measures the performance of constructing a new empty Queue
 // and calling Push to fill it with elements.
 func BenchmarkNew(b *testing.B) {
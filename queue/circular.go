@@ -0,0 +1,96 @@
+package queue
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/FollowTheProcess/collections/ringbuffer"
+)
+
+// Circular is a fixed capacity FIFO queue where pushing onto a full queue overwrites
+// the oldest item, rather than growing like [Queue].
+//
+// It is backed by a [ringbuffer.RingBuffer] allocated once on construction, so it never
+// allocates again, making it ideal for keeping the last N items of an unbounded stream e.g. log events.
+//
+// A Circular must be instantiated with [NewCircular] as the zero value has no capacity.
+type Circular[T any] struct {
+	buffer *ringbuffer.RingBuffer[T] // Underlying ring buffer
+}
+
+// NewCircular constructs and returns a new [Circular] queue with the given capacity.
+//
+// A capacity <= 0 results in a queue that discards everything pushed to it.
+func NewCircular[T any](capacity int) *Circular[T] {
+	return &Circular[T]{buffer: ringbuffer.New[T](capacity)}
+}
+
+// Push adds an item to the back of the queue.
+//
+// If the queue is full, the oldest item is dropped to make room and returned along
+// with true, otherwise the zero value and false are returned.
+//
+//	q := queue.NewCircular[int](2)
+//	q.Push(1)
+//	q.Push(2)
+//	evicted, ok := q.Push(3) // 1, true
+func (c *Circular[T]) Push(item T) (evicted T, ok bool) {
+	if c.buffer.Cap() == 0 {
+		// Nowhere to put it, so it's immediately dropped
+		return item, true
+	}
+
+	if c.buffer.Full() {
+		// The ring buffer will overwrite the oldest, grab it first
+		evicted, _ = c.buffer.Get(0) //nolint: errcheck // Only error is out of range, and the buffer is full
+		ok = true
+	}
+
+	c.buffer.Push(item)
+
+	return evicted, ok
+}
+
+// Pop removes and returns the oldest item in the queue, if the
+// queue is empty, an error will be returned.
+func (c *Circular[T]) Pop() (T, error) {
+	if c.buffer.IsEmpty() {
+		var none T
+
+		return none, errors.New("pop from empty queue")
+	}
+
+	return c.buffer.Pop()
+}
+
+// Len returns the number of items currently in the queue.
+func (c *Circular[T]) Len() int {
+	return c.buffer.Len()
+}
+
+// Cap returns the capacity of the queue, i.e. the maximum number of items
+// it can hold before overwriting.
+func (c *Circular[T]) Cap() int {
+	return c.buffer.Cap()
+}
+
+// Full reports whether the queue is at capacity, in which case the next
+// Push will overwrite the oldest item.
+func (c *Circular[T]) Full() bool {
+	return c.buffer.Full()
+}
+
+// IsEmpty returns whether or not the queue is empty.
+func (c *Circular[T]) IsEmpty() bool {
+	return c.buffer.IsEmpty()
+}
+
+// All returns an iterator over the items in the queue, from oldest to newest.
+func (c *Circular[T]) All() iter.Seq[T] {
+	return c.buffer.All()
+}
+
+// String satisfies the [fmt.Stringer] interface and allows a Circular queue to be printed.
+func (c *Circular[T]) String() string {
+	return c.buffer.String()
+}
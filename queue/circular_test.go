@@ -0,0 +1,61 @@
+package queue_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/queue"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestCircular(t *testing.T) {
+	q := queue.NewCircular[int](3)
+	test.Equal(t, q.Cap(), 3)
+	test.True(t, q.IsEmpty())
+
+	for i := range 3 {
+		_, evicted := q.Push(i)
+		test.False(t, evicted)
+	}
+
+	test.True(t, q.Full())
+	test.Equal(t, q.Len(), 3)
+
+	item, evicted := q.Push(3)
+	test.True(t, evicted)
+	test.Equal(t, item, 0)
+
+	item, evicted = q.Push(4)
+	test.True(t, evicted)
+	test.Equal(t, item, 1)
+
+	test.EqualFunc(t, slices.Collect(q.All()), []int{2, 3, 4}, slices.Equal)
+	test.Equal(t, q.String(), "[2 3 4]")
+	test.Equal(t, q.Cap(), 3) // Never grows
+
+	first, err := q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, first, 2)
+	test.False(t, q.Full())
+
+	_, evicted = q.Push(5)
+	test.False(t, evicted)
+	test.EqualFunc(t, slices.Collect(q.All()), []int{3, 4, 5}, slices.Equal)
+
+	for range 3 {
+		_, err = q.Pop()
+		test.Ok(t, err)
+	}
+
+	_, err = q.Pop()
+	test.Err(t, err)
+}
+
+func TestCircularZeroCapacity(t *testing.T) {
+	q := queue.NewCircular[string](0)
+
+	item, evicted := q.Push("hello")
+	test.True(t, evicted) // Dropped straight away
+	test.Equal(t, item, "hello")
+	test.True(t, q.IsEmpty())
+}
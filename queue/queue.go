@@ -1,7 +1,10 @@
 // Package queue implements a FIFO queue generic over any type.
 //
+// The queue is backed by a ring buffer so popped items do not keep the
+// underlying memory alive, making it suitable for long lived queues with heavy churn.
+//
 // The queue is not safe for concurrent access across goroutines, the caller is responsible for
-// synchronising concurrent access.
+// synchronising concurrent access, or may use [SyncQueue] which does this for them.
 package queue
 
 import (
@@ -10,11 +13,31 @@ import (
 	"iter"
 )
 
+// minCapacity is the capacity allocated the first time an item is pushed
+// into a queue with no capacity.
+const minCapacity = 4
+
+// Policy controls the behaviour of a bounded [Queue] when an item is
+// pushed while it is full.
+type Policy int
+
+const (
+	// Reject refuses new items while the queue is full.
+	Reject Policy = iota
+
+	// DropOldest evicts an existing item to make room for the new one.
+	DropOldest
+)
+
 // Queue is a FIFO queue generic over any type.
 //
 // A Queue should be instantiated by the New function and not directly.
 type Queue[T any] struct {
-	container []T // Underlying slice
+	container []T    // Underlying ring buffer, len(container) is the capacity of the queue
+	head      int    // Index in container of the item at the front of the queue
+	size      int    // The number of items currently in the queue
+	maxSize   int    // The maximum number of items the queue may hold, 0 means unbounded
+	policy    Policy // What to do when pushing to a full bounded queue
 }
 
 // New constructs and returns a new Queue.
@@ -27,7 +50,23 @@ func New[T any]() *Queue[T] {
 // This can be a useful performance improvement when the expected maximum size of the queue is
 // known ahead of time as it eliminates the need for reallocation.
 func WithCapacity[T any](capacity int) *Queue[T] {
-	return &Queue[T]{container: make([]T, 0, capacity)}
+	return &Queue[T]{container: make([]T, capacity)}
+}
+
+// Bounded constructs and returns a new Queue that may hold at most maxSize items.
+//
+// The policy determines what happens when an item is pushed while the queue is full,
+// [Reject] refuses the new item and [DropOldest] evicts the item at the front of the
+// queue to make room for it. Use [Queue.TryPush] to find out whether an item was accepted.
+//
+// A maxSize <= 0 results in an unbounded queue.
+//
+//	q := queue.Bounded[string](2, queue.Reject)
+//	q.TryPush("hello") // true
+//	q.TryPush("there") // true
+//	q.TryPush("general") // false
+func Bounded[T any](maxSize int, policy Policy) *Queue[T] {
+	return &Queue[T]{container: make([]T, max(maxSize, 0)), maxSize: max(maxSize, 0), policy: policy}
 }
 
 // From builds a [Queue] from an existing slice of items, pushing items
@@ -36,9 +75,8 @@ func WithCapacity[T any](capacity int) *Queue[T] {
 // The queue will be preallocated the size of len(items).
 func From[T any](items []T) *Queue[T] {
 	queue := WithCapacity[T](len(items))
-	for _, item := range items {
-		queue.Push(item)
-	}
+	copy(queue.container, items)
+	queue.size = len(items)
 
 	return queue
 }
@@ -56,34 +94,245 @@ func Collect[T any](items iter.Seq[T]) *Queue[T] {
 
 // Push adds an item to the back of the queue.
 //
+// If the queue was created with [Bounded] and is full, the item is handled
+// according to the queue's [Policy], use [Queue.TryPush] to find out whether
+// it was accepted.
+//
+// Push is amortised O(1).
+//
 //	q := queue.New[string]()
 //	q.Push("hello")
 func (q *Queue[T]) Push(item T) {
-	q.container = append(q.container, item)
+	q.TryPush(item)
+}
+
+// TryPush adds an item to the back of the queue, reporting whether it
+// was accepted.
+//
+// TryPush only returns false when the queue was created with [Bounded] using
+// the [Reject] policy and is full. Under [DropOldest] the item at the front of
+// the queue is evicted to make room and TryPush returns true.
+//
+//	q := queue.Bounded[string](1, queue.Reject)
+//	q.TryPush("hello") // true
+//	q.TryPush("there") // false
+func (q *Queue[T]) TryPush(item T) bool {
+	if q.isFull() {
+		if q.policy != DropOldest {
+			return false
+		}
+
+		_, _ = q.Pop() //nolint: errcheck // Only error is pop from empty queue
+	}
+
+	q.growIfFull()
+	q.container[q.index(q.size)] = item
+	q.size++
+
+	return true
+}
+
+// PushMany adds a number of items to the back of the queue, in the order
+// they are given.
+//
+// The queue is grown at most once to fit all the items, making this more efficient
+// than calling [Queue.Push] in a loop.
+//
+//	q := queue.New[string]()
+//	q.PushMany("hello", "there")
+//	item, _ := q.Pop()
+//	fmt.Println(item) // "hello"
+func (q *Queue[T]) PushMany(items ...T) {
+	if q.maxSize > 0 {
+		// Each item must be subject to the queue's policy
+		for _, item := range items {
+			q.TryPush(item)
+		}
+
+		return
+	}
+
+	if required := q.size + len(items); required > len(q.container) {
+		q.resize(max(required, 2*len(q.container))) //nolint: mnd // Doubling, 2 is not magic
+	}
+
+	for _, item := range items {
+		q.container[q.index(q.size)] = item
+		q.size++
+	}
+}
+
+// PushFront adds an item to the front of the queue, so it will be
+// the next item to be popped.
+//
+// If the queue was created with [Bounded] and is full, under [Reject] the item
+// is discarded and under [DropOldest] the item at the back of the queue is evicted
+// to make room.
+//
+// PushFront is amortised O(1).
+//
+//	q := queue.New[string]()
+//	q.Push("there")
+//	q.PushFront("hello")
+//	item, _ := q.Pop()
+//	fmt.Println(item) // "hello"
+func (q *Queue[T]) PushFront(item T) {
+	if q.isFull() {
+		if q.policy != DropOldest {
+			return
+		}
+
+		_, _ = q.PopBack() //nolint: errcheck // Only error is pop from empty queue
+	}
+
+	q.growIfFull()
+	q.head = q.index(len(q.container) - 1) // One step back, wrapping if necessary
+	q.container[q.head] = item
+	q.size++
 }
 
 // Pop removes an item from the front of the queue, if the queue
 // is empty, an error will be returned.
 //
+// Pop is O(1).
+//
 //	q := queue.New[string]()
 //	q.Push("hello")
 //	q.Push("there")
 //	item, _ := q.Pop()
 //	fmt.Println(item) // "hello"
 func (q *Queue[T]) Pop() (T, error) {
-	l := len(q.container)
-	if l == 0 {
-		var none T
+	var none T
+	if q.size == 0 {
+		return none, errors.New("pop from empty queue")
+	}
+
+	item := q.container[q.head]
+	q.container[q.head] = none // Don't hold on to the item so it may be garbage collected
+	q.head = q.index(1)
+	q.size--
+
+	return item, nil
+}
 
+// PopBack removes an item from the back of the queue, i.e. the item
+// most recently pushed with [Queue.Push]. If the queue is empty, an
+// error will be returned.
+//
+// PopBack is O(1).
+//
+//	q := queue.New[string]()
+//	q.Push("hello")
+//	q.Push("there")
+//	item, _ := q.PopBack()
+//	fmt.Println(item) // "there"
+func (q *Queue[T]) PopBack() (T, error) {
+	var none T
+	if q.size == 0 {
 		return none, errors.New("pop from empty queue")
 	}
 
-	item := (q.container)[0]
-	q.container = (q.container)[1:]
+	index := q.index(q.size - 1)
+	item := q.container[index]
+	q.container[index] = none // Don't hold on to the item so it may be garbage collected
+	q.size--
 
 	return item, nil
 }
 
+// Peek returns the item at the front of the queue without removing it, if the
+// queue is empty, an error will be returned.
+//
+//	q := queue.New[string]()
+//	q.Push("hello")
+//	q.Push("there")
+//	item, _ := q.Peek()
+//	fmt.Println(item) // "hello"
+//	q.Size() // 2
+func (q *Queue[T]) Peek() (T, error) {
+	if q.size == 0 {
+		var none T
+
+		return none, errors.New("peek from empty queue")
+	}
+
+	return q.container[q.head], nil
+}
+
+// At returns the item at the given index from the front of the queue without
+// removing it, where index 0 is the next item to be popped.
+//
+// If the index is out of range, an error will be returned. Because the queue
+// is backed by a ring buffer, At is O(1).
+//
+//	q := queue.New[string]()
+//	q.Push("hello")
+//	q.Push("there")
+//	item, _ := q.At(1)
+//	fmt.Println(item) // "there"
+func (q *Queue[T]) At(index int) (T, error) {
+	if index < 0 || index >= q.size {
+		var none T
+
+		return none, fmt.Errorf("index %d out of range for queue of size %d", index, q.size)
+	}
+
+	return q.container[q.index(index)], nil
+}
+
+// Clear removes all the items from the queue, retaining the allocated capacity
+// so the queue may be reused without the need for reallocation.
+//
+//	q := queue.New[string]()
+//	q.Push("hello")
+//	q.Push("there")
+//	q.Clear()
+//	q.IsEmpty() // true
+func (q *Queue[T]) Clear() {
+	clear(q.container) // Zero the items so they may be garbage collected
+	q.head = 0
+	q.size = 0
+}
+
+// Clone returns a new [Queue] containing a copy of the items in q.
+//
+// The returned queue is entirely independent of q, mutating one
+// will not affect the other.
+//
+//	q := queue.New[string]()
+//	q.Push("hello")
+//	other := q.Clone()
+//	other.Push("there")
+//	q.Size() // 1
+//	other.Size() // 2
+func (q *Queue[T]) Clone() *Queue[T] {
+	clone := WithCapacity[T](len(q.container))
+	q.copyTo(clone.container)
+	clone.size = q.size
+	clone.maxSize = q.maxSize
+	clone.policy = q.policy
+
+	return clone
+}
+
+// Grow increases the capacity of the queue, if necessary, to guarantee space
+// for another n items to be pushed without the need for reallocation.
+//
+// If n is negative, Grow will panic.
+//
+//	q := queue.New[string]()
+//	q.Grow(10)
+//	q.Capacity() // 10
+func (q *Queue[T]) Grow(n int) {
+	if n < 0 {
+		panic("queue.Grow: negative n")
+	}
+
+	if required := q.size + n; required > len(q.container) {
+		q.resize(required)
+	}
+}
+
 // Size returns the number of items in the queue.
 //
 //	s := queue.New[string]()
@@ -92,7 +341,7 @@ func (q *Queue[T]) Pop() (T, error) {
 //	s.Push("there")
 //	s.Size() // 2
 func (q *Queue[T]) Size() int {
-	return len(q.container)
+	return q.size
 }
 
 // Capacity returns the capacity of the queue, i.e. the number of items
@@ -103,7 +352,7 @@ func (q *Queue[T]) Size() int {
 //	q := queue.WithCapacity[string](10)
 //	q.Capacity() // 10
 func (q *Queue[T]) Capacity() int {
-	return cap(q.container)
+	return len(q.container)
 }
 
 // IsEmpty returns whether or not the queue is empty.
@@ -113,7 +362,16 @@ func (q *Queue[T]) Capacity() int {
 //	s.Push("hello")
 //	s.IsEmpty() // false
 func (q *Queue[T]) IsEmpty() bool {
-	return len(q.container) == 0
+	return q.size == 0
+}
+
+// Len returns the number of items currently in the queue.
+//
+// It is equivalent to [Queue.Size] and allows a Queue to be used as a [collections.Collection].
+//
+// [collections.Collection]: https://pkg.go.dev/github.com/FollowTheProcess/collections#Collection
+func (q *Queue[T]) Len() int {
+	return q.size
 }
 
 // All returns the an iterator over the queue in FIFO order.
@@ -124,7 +382,29 @@ func (q *Queue[T]) IsEmpty() bool {
 //	qlices.Collect(s.All()) // [hello there]
 func (q *Queue[T]) All() iter.Seq[T] {
 	return func(yield func(T) bool) {
-		for _, item := range q.container {
+		for i := range q.size {
+			if !yield(q.container[q.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops items from the queue in FIFO order,
+// consuming the queue as it goes.
+//
+// Unlike [Queue.All], the queue is modified by iteration. If iteration stops early,
+// any items not yet yielded remain in the queue.
+//
+//	q := queue.New[string]()
+//	q.Push("hello")
+//	q.Push("there")
+//	slices.Collect(q.Drain()) // [hello there]
+//	q.IsEmpty() // true
+func (q *Queue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !q.IsEmpty() {
+			item, _ := q.Pop() //nolint: errcheck // Only error is pop from empty queue
 			if !yield(item) {
 				return
 			}
@@ -134,5 +414,90 @@ func (q *Queue[T]) All() iter.Seq[T] {
 
 // String satisfies the [fmt.Stringer] interface and allows a Queue to be printed.
 func (q *Queue[T]) String() string {
-	return fmt.Sprintf("%v", q.container)
+	items := make([]T, q.size)
+	q.copyTo(items)
+
+	return fmt.Sprintf("%v", items)
+}
+
+// Contains reports whether the queue contains target.
+//
+// It is a function rather than a method as it requires the items
+// in the queue to be comparable.
+//
+//	q := queue.New[string]()
+//	q.Push("hello")
+//	queue.Contains(q, "hello") // true
+//	queue.Contains(q, "there") // false
+func Contains[T comparable](q *Queue[T], target T) bool {
+	for item := range q.All() {
+		if item == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Equal returns whether two queues are equal to one another, i.e. they contain
+// exactly the same items in the same FIFO order.
+//
+// If either of the two queues are nil, Equal returns false.
+func Equal[T comparable](a, b *Queue[T]) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	if a.size != b.size {
+		return false
+	}
+
+	for i := range a.size {
+		if a.container[a.index(i)] != b.container[b.index(i)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// index returns the position in the underlying container of the
+// item i places from the front of the queue.
+func (q *Queue[T]) index(i int) int {
+	return (q.head + i) % len(q.container)
+}
+
+// copyTo copies the items in the queue into dst in FIFO order, dst must have
+// a length of at least q.size.
+func (q *Queue[T]) copyTo(dst []T) {
+	if q.size == 0 {
+		return
+	}
+
+	// The items may wrap around the end of the container, in which case
+	// there are two contiguous chunks to copy
+	n := copy(dst, q.container[q.head:min(q.head+q.size, len(q.container))])
+	copy(dst[n:q.size], q.container)
+}
+
+// isFull reports whether the queue is bounded and has reached its maximum size.
+func (q *Queue[T]) isFull() bool {
+	return q.maxSize > 0 && q.size >= q.maxSize
+}
+
+// growIfFull doubles the capacity of the queue if there is no room
+// for another item.
+func (q *Queue[T]) growIfFull() {
+	if q.size == len(q.container) {
+		q.resize(max(2*len(q.container), minCapacity)) //nolint: mnd // Doubling, 2 is not magic
+	}
+}
+
+// resize reallocates the underlying container with the given capacity, laying
+// the items out contiguously from the start of the new container.
+func (q *Queue[T]) resize(capacity int) {
+	container := make([]T, capacity)
+	q.copyTo(container)
+	q.container = container
+	q.head = 0
 }
@@ -63,6 +63,346 @@ func TestPop(t *testing.T) {
 	test.Err(t, err)
 }
 
+func TestWrapAround(t *testing.T) {
+	q := queue.WithCapacity[int](4)
+
+	// Continually push and pop so the front of the queue wraps round the
+	// end of the underlying buffer many times
+	for i := range 100 {
+		q.Push(i)
+		q.Push(i + 1000)
+
+		item, err := q.Pop()
+		test.Ok(t, err)
+		test.Equal(t, item, i)
+
+		item, err = q.Pop()
+		test.Ok(t, err)
+		test.Equal(t, item, i+1000)
+	}
+
+	test.True(t, q.IsEmpty())
+	test.Equal(t, q.Capacity(), 4) // Churn should not grow the queue
+
+	// Fill it past capacity while wrapped, forcing a resize
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+	_, err := q.Pop()
+	test.Ok(t, err)
+
+	q.Push(4)
+	q.Push(5)
+	q.Push(6)
+
+	test.Equal(t, q.String(), "[2 3 4 5 6]")
+	test.EqualFunc(t, slices.Collect(q.All()), []int{2, 3, 4, 5, 6}, slices.Equal)
+}
+
+func TestBounded(t *testing.T) {
+	t.Run("reject", func(t *testing.T) {
+		q := queue.Bounded[string](2, queue.Reject)
+		test.Equal(t, q.Capacity(), 2)
+
+		test.True(t, q.TryPush("hello"))
+		test.True(t, q.TryPush("there"))
+		test.False(t, q.TryPush("general")) // Full
+		q.Push("kenobi")                    // Should also be rejected
+		q.PushFront("obi-wan")              // And this
+		q.PushMany("droids", "bounty")      // And these
+
+		test.Equal(t, q.Size(), 2)
+		test.Equal(t, q.Capacity(), 2) // Should never grow
+		test.EqualFunc(t, slices.Collect(q.All()), []string{"hello", "there"}, slices.Equal)
+
+		// Making room should allow pushes again
+		_, err := q.Pop()
+		test.Ok(t, err)
+		test.True(t, q.TryPush("general"))
+		test.EqualFunc(t, slices.Collect(q.All()), []string{"there", "general"}, slices.Equal)
+	})
+
+	t.Run("drop oldest", func(t *testing.T) {
+		q := queue.Bounded[int](3, queue.DropOldest)
+
+		for i := range 10 {
+			test.True(t, q.TryPush(i))
+		}
+
+		test.Equal(t, q.Size(), 3)
+		test.Equal(t, q.Capacity(), 3)
+		test.EqualFunc(t, slices.Collect(q.All()), []int{7, 8, 9}, slices.Equal)
+
+		q.PushMany(10, 11)
+		test.EqualFunc(t, slices.Collect(q.All()), []int{9, 10, 11}, slices.Equal)
+
+		// Pushing on the front of a full queue evicts the back
+		q.PushFront(8)
+		test.EqualFunc(t, slices.Collect(q.All()), []int{8, 9, 10}, slices.Equal)
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		q := queue.Bounded[int](1, queue.Reject)
+		q.Push(1)
+
+		clone := q.Clone()
+		test.False(t, clone.TryPush(2)) // Clone should keep the bound
+	})
+
+	t.Run("unbounded", func(t *testing.T) {
+		q := queue.Bounded[int](0, queue.Reject)
+
+		for i := range 10 {
+			test.True(t, q.TryPush(i))
+		}
+
+		test.Equal(t, q.Size(), 10)
+	})
+}
+
+func TestPushMany(t *testing.T) {
+	q := queue.New[string]()
+	q.PushMany() // Nothing should happen
+	test.True(t, q.IsEmpty())
+
+	q.Push("hello")
+	q.PushMany("there", "general", "kenobi")
+
+	test.Equal(t, q.Size(), 4)
+	test.EqualFunc(t, slices.Collect(q.All()), []string{"hello", "there", "general", "kenobi"}, slices.Equal)
+}
+
+func TestPushFront(t *testing.T) {
+	q := queue.New[string]()
+	q.Push("general")
+	q.PushFront("there")
+	q.Push("kenobi")
+	q.PushFront("hello")
+
+	test.Equal(t, q.Size(), 4)
+	test.EqualFunc(t, slices.Collect(q.All()), []string{"hello", "there", "general", "kenobi"}, slices.Equal)
+
+	item, err := q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "hello")
+}
+
+func TestPopBack(t *testing.T) {
+	q := queue.New[string]()
+	q.Push("hello")
+	q.Push("there")
+	q.Push("general")
+
+	item, err := q.PopBack()
+	test.Ok(t, err)
+	test.Equal(t, item, "general")
+
+	item, err = q.PopBack()
+	test.Ok(t, err)
+	test.Equal(t, item, "there")
+
+	item, err = q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "hello")
+
+	// Try one more pop, should error
+	_, err = q.PopBack()
+	test.Err(t, err)
+}
+
+func TestPeek(t *testing.T) {
+	q := queue.New[string]()
+
+	_, err := q.Peek()
+	test.Err(t, err) // Peek from empty queue should error
+
+	q.Push("hello")
+	q.Push("there")
+
+	item, err := q.Peek()
+	test.Ok(t, err)
+	test.Equal(t, item, "hello")
+
+	test.Equal(t, q.Size(), 2) // Peek should not remove the item
+
+	// Peeking again should return the same thing
+	item, err = q.Peek()
+	test.Ok(t, err)
+	test.Equal(t, item, "hello")
+}
+
+func TestAt(t *testing.T) {
+	q := queue.New[string]()
+
+	_, err := q.At(0)
+	test.Err(t, err) // Empty queue
+
+	q.Push("hello")
+	q.Push("there")
+	q.Push("general")
+
+	first, err := q.At(0)
+	test.Ok(t, err)
+	test.Equal(t, first, "hello")
+
+	last, err := q.At(2)
+	test.Ok(t, err)
+	test.Equal(t, last, "general")
+
+	_, err = q.At(3)
+	test.Err(t, err) // Out of range
+
+	_, err = q.At(-1)
+	test.Err(t, err) // Negative
+
+	test.Equal(t, q.Size(), 3) // At should not remove anything
+}
+
+func TestGrow(t *testing.T) {
+	q := queue.New[int]()
+	q.Push(1)
+	q.Grow(10)
+
+	test.Equal(t, q.Capacity(), 11)
+	test.Equal(t, q.Size(), 1) // Grow should not add items
+
+	// Already enough room, should be a no-op
+	q.Grow(5)
+	test.Equal(t, q.Capacity(), 11)
+
+	item, err := q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, 1)
+}
+
+func TestClear(t *testing.T) {
+	q := queue.WithCapacity[string](10)
+	q.Push("hello")
+	q.Push("there")
+	q.Push("general")
+	q.Push("kenobi")
+
+	test.Equal(t, q.Size(), 4)
+
+	q.Clear()
+
+	test.True(t, q.IsEmpty())
+	test.Equal(t, q.Size(), 0)
+	test.Equal(t, q.Capacity(), 10) // Clear should retain capacity
+
+	// Should be reusable
+	q.Push("again")
+
+	item, err := q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "again")
+}
+
+func TestClone(t *testing.T) {
+	q := queue.New[string]()
+	q.Push("hello")
+	q.Push("there")
+
+	other := q.Clone()
+	test.EqualFunc(t, slices.Collect(other.All()), slices.Collect(q.All()), slices.Equal)
+
+	// Mutating one should not affect the other
+	other.Push("general")
+
+	item, err := q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "hello")
+
+	test.Equal(t, q.Size(), 1)
+	test.Equal(t, other.Size(), 3)
+
+	first, err := other.Pop()
+	test.Ok(t, err)
+	test.Equal(t, first, "hello") // Clone should be unaffected by the Pop on the original
+}
+
+func TestContains(t *testing.T) {
+	q := queue.New[string]()
+	test.False(t, queue.Contains(q, "hello")) // Empty queue contains nothing
+
+	q.Push("hello")
+	q.Push("there")
+	q.Push("general")
+
+	test.True(t, queue.Contains(q, "hello"))
+	test.True(t, queue.Contains(q, "general"))
+	test.False(t, queue.Contains(q, "kenobi"))
+
+	// Popped items should no longer be found
+	_, err := q.Pop()
+	test.Ok(t, err)
+	test.False(t, queue.Contains(q, "hello"))
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b *queue.Queue[string] // Queues to compare
+		name string               // Name of the test case
+		want bool                 // Whether they should be considered equal
+	}{
+		{
+			name: "nil",
+			a:    nil,
+			b:    nil,
+			want: false,
+		},
+		{
+			name: "one nil",
+			a:    queue.New[string](),
+			b:    nil,
+			want: false,
+		},
+		{
+			name: "empty",
+			a:    queue.New[string](),
+			b:    queue.WithCapacity[string](10),
+			want: true,
+		},
+		{
+			name: "same",
+			a:    queue.From([]string{"hello", "there"}),
+			b:    queue.From([]string{"hello", "there"}),
+			want: true,
+		},
+		{
+			name: "different order",
+			a:    queue.From([]string{"hello", "there"}),
+			b:    queue.From([]string{"there", "hello"}),
+			want: false,
+		},
+		{
+			name: "different size",
+			a:    queue.From([]string{"hello", "there"}),
+			b:    queue.From([]string{"hello", "there", "general"}),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.Equal(t, queue.Equal(tt.a, tt.b), tt.want)
+		})
+	}
+
+	t.Run("wrapped", func(t *testing.T) {
+		// Same items, but laid out differently in the underlying buffers
+		a := queue.WithCapacity[string](3)
+		a.PushMany("x", "hello", "there")
+		_, err := a.Pop()
+		test.Ok(t, err)
+		a.Push("general")
+
+		b := queue.From([]string{"hello", "there", "general"})
+
+		test.True(t, queue.Equal(a, b))
+	})
+}
+
 func TestItems(t *testing.T) {
 	q := queue.New[string]()
 	q.Push("hello")
@@ -76,6 +416,31 @@ func TestItems(t *testing.T) {
 	test.EqualFunc(t, got, want, slices.Equal)
 }
 
+func TestDrain(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		q := queue.From([]string{"hello", "there", "general", "kenobi"})
+
+		got := slices.Collect(q.Drain())
+		want := []string{"hello", "there", "general", "kenobi"}
+
+		test.EqualFunc(t, got, want, slices.Equal)
+		test.True(t, q.IsEmpty()) // Drain should consume the queue
+	})
+
+	t.Run("early return", func(t *testing.T) {
+		q := queue.From([]string{"hello", "there", "general", "kenobi"})
+
+		for item := range q.Drain() {
+			if item == "there" {
+				break
+			}
+		}
+
+		want := []string{"general", "kenobi"}
+		test.EqualFunc(t, slices.Collect(q.All()), want, slices.Equal)
+	})
+}
+
 func TestString(t *testing.T) {
 	q := queue.New[string]()
 	q.Push("hello")
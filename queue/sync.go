@@ -0,0 +1,96 @@
+package queue
+
+import (
+	"context"
+	"sync"
+)
+
+// SyncQueue is a FIFO [Queue] that is safe for concurrent access across goroutines.
+//
+// As well as the usual non-blocking methods, it offers [SyncQueue.PopWait] which blocks
+// until an item is available, making it suitable for producer/consumer workloads.
+//
+// A SyncQueue must be instantiated by the NewSync function and not directly.
+type SyncQueue[T any] struct {
+	queue *Queue[T]  // The wrapped queue
+	cond  *sync.Cond // Signalled when an item is pushed, uses mu as its lock
+	mu    sync.Mutex // Guards queue
+}
+
+// NewSync constructs and returns a new [SyncQueue].
+func NewSync[T any]() *SyncQueue[T] {
+	q := &SyncQueue[T]{queue: New[T]()}
+	q.cond = sync.NewCond(&q.mu)
+
+	return q
+}
+
+// Push adds an item to the back of the queue, waking up one goroutine
+// blocked in [SyncQueue.PopWait] if there are any.
+func (q *SyncQueue[T]) Push(item T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Push(item)
+	q.cond.Signal()
+}
+
+// Pop removes an item from the front of the queue, if the queue
+// is empty, an error will be returned immediately.
+//
+// To wait for an item to become available, use [SyncQueue.PopWait].
+func (q *SyncQueue[T]) Pop() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Pop()
+}
+
+// PopWait removes an item from the front of the queue, blocking until one is
+// available or ctx is cancelled.
+//
+// If ctx is cancelled before an item becomes available, the context's error
+// is returned.
+func (q *SyncQueue[T]) PopWait(ctx context.Context) (T, error) {
+	// Wake everyone up on cancellation so the waiter for this ctx can notice. The lock
+	// must be taken before broadcasting or the wakeup could be missed between a waiter
+	// checking ctx.Err() and calling Wait
+	stop := context.AfterFunc(ctx, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		q.cond.Broadcast()
+	})
+	defer stop()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.queue.IsEmpty() {
+		if err := ctx.Err(); err != nil {
+			var none T
+
+			return none, err
+		}
+
+		q.cond.Wait()
+	}
+
+	return q.queue.Pop()
+}
+
+// Size returns the number of items in the queue.
+func (q *SyncQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Size()
+}
+
+// IsEmpty returns whether or not the queue is empty.
+func (q *SyncQueue[T]) IsEmpty() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.IsEmpty()
+}
@@ -0,0 +1,92 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FollowTheProcess/collections/queue"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestSyncQueue(t *testing.T) {
+	q := queue.NewSync[string]()
+	test.True(t, q.IsEmpty())
+
+	_, err := q.Pop()
+	test.Err(t, err) // Pop from empty queue should not block
+
+	q.Push("hello")
+	q.Push("there")
+	test.Equal(t, q.Size(), 2)
+
+	item, err := q.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "hello")
+}
+
+func TestSyncQueuePopWait(t *testing.T) {
+	t.Run("already available", func(t *testing.T) {
+		q := queue.NewSync[int]()
+		q.Push(1)
+
+		item, err := q.PopWait(context.Background())
+		test.Ok(t, err)
+		test.Equal(t, item, 1)
+	})
+
+	t.Run("producer consumer", func(t *testing.T) {
+		q := queue.NewSync[int]()
+
+		const n = 100
+
+		var wg sync.WaitGroup
+
+		results := make(chan int, n)
+
+		for range 4 {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for range n / 4 {
+					item, err := q.PopWait(context.Background())
+					if err != nil {
+						t.Errorf("PopWait returned an unexpected error: %v", err)
+						return
+					}
+
+					results <- item
+				}
+			}()
+		}
+
+		for i := range n {
+			q.Push(i)
+		}
+
+		wg.Wait()
+		close(results)
+
+		sum := 0
+		for item := range results {
+			sum += item
+		}
+
+		test.Equal(t, sum, n*(n-1)/2)
+		test.True(t, q.IsEmpty())
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		q := queue.NewSync[int]()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := q.PopWait(ctx)
+		test.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+}
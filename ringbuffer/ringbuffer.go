@@ -0,0 +1,142 @@
+// Package ringbuffer implements a fixed size circular buffer generic over any type.
+//
+// Once full, pushing a new item to the buffer overwrites the oldest one, making it ideal
+// for tracking the last N items of a stream e.g. for sliding windows.
+//
+// The buffer is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package ringbuffer
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// RingBuffer is a fixed size circular buffer.
+//
+// A RingBuffer must be instantiated with [New] as the zero value has no capacity.
+type RingBuffer[T any] struct {
+	container []T // Underlying buffer, allocated once with the full capacity
+	head      int // Index in container of the oldest item
+	size      int // The number of items currently in the buffer
+}
+
+// New constructs and returns a new [RingBuffer] with the given capacity.
+//
+// The full capacity is allocated up front, and the buffer never allocates again.
+// A capacity <= 0 results in a buffer that discards everything pushed to it.
+func New[T any](capacity int) *RingBuffer[T] {
+	return &RingBuffer[T]{container: make([]T, max(capacity, 0))}
+}
+
+// Push adds an item to the buffer, if the buffer is full the oldest item
+// is overwritten.
+//
+//	r := ringbuffer.New[int](2)
+//	r.Push(1)
+//	r.Push(2)
+//	r.Push(3) // Overwrites 1
+//	slices.Collect(r.All()) // [2 3]
+func (r *RingBuffer[T]) Push(item T) {
+	if len(r.container) == 0 {
+		return
+	}
+
+	if r.size == len(r.container) {
+		// Full, overwrite the oldest and move the head on
+		r.container[r.head] = item
+		r.head = r.index(1)
+
+		return
+	}
+
+	r.container[r.index(r.size)] = item
+	r.size++
+}
+
+// Pop removes and returns the oldest item in the buffer.
+//
+// If the buffer is empty, an error will be returned.
+func (r *RingBuffer[T]) Pop() (T, error) {
+	var none T
+	if r.size == 0 {
+		return none, errors.New("pop from empty ring buffer")
+	}
+
+	item := r.container[r.head]
+	r.container[r.head] = none // Don't hold on to the item so it may be garbage collected
+	r.head = r.index(1)
+	r.size--
+
+	return item, nil
+}
+
+// Get returns the item at index i in the buffer, where 0 is the oldest item.
+//
+// If the index is out of range, an error will be returned.
+func (r *RingBuffer[T]) Get(i int) (T, error) {
+	if i < 0 || i >= r.size {
+		var zero T
+
+		return zero, fmt.Errorf("index %d out of range for ring buffer of length %d", i, r.size)
+	}
+
+	return r.container[r.index(i)], nil
+}
+
+// Len returns the number of items currently in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the capacity of the buffer, i.e. the maximum number of items
+// it can hold before overwriting.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.container)
+}
+
+// Full reports whether the buffer is full, in which case the next push
+// will overwrite the oldest item.
+func (r *RingBuffer[T]) Full() bool {
+	return r.size == len(r.container)
+}
+
+// IsEmpty reports whether the buffer is empty.
+func (r *RingBuffer[T]) IsEmpty() bool {
+	return r.size == 0
+}
+
+// Reset removes all the items from the buffer, retaining its capacity.
+func (r *RingBuffer[T]) Reset() {
+	clear(r.container)
+	r.head = 0
+	r.size = 0
+}
+
+// All returns an iterator over the items in the buffer, from oldest to newest.
+func (r *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range r.size {
+			if !yield(r.container[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// String satisfies the [fmt.Stringer] interface and allows a RingBuffer to be printed.
+func (r *RingBuffer[T]) String() string {
+	items := make([]T, 0, r.size)
+	for item := range r.All() {
+		items = append(items, item)
+	}
+
+	return fmt.Sprintf("%v", items)
+}
+
+// index returns the position in the underlying container of the
+// item i places from the oldest.
+func (r *RingBuffer[T]) index(i int) int {
+	return (r.head + i) % len(r.container)
+}
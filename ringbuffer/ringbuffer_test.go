@@ -0,0 +1,111 @@
+package ringbuffer_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/ringbuffer"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestPush(t *testing.T) {
+	r := ringbuffer.New[int](3)
+	test.Equal(t, r.Cap(), 3)
+	test.Equal(t, r.Len(), 0)
+	test.True(t, r.IsEmpty())
+	test.False(t, r.Full())
+
+	r.Push(1)
+	r.Push(2)
+	test.Equal(t, r.Len(), 2)
+	test.False(t, r.Full())
+
+	r.Push(3)
+	test.True(t, r.Full())
+	test.EqualFunc(t, slices.Collect(r.All()), []int{1, 2, 3}, slices.Equal)
+
+	// Now it should start overwriting
+	r.Push(4)
+	r.Push(5)
+	test.Equal(t, r.Len(), 3)
+	test.Equal(t, r.Cap(), 3)
+	test.EqualFunc(t, slices.Collect(r.All()), []int{3, 4, 5}, slices.Equal)
+	test.Equal(t, r.String(), "[3 4 5]")
+}
+
+func TestPop(t *testing.T) {
+	r := ringbuffer.New[int](3)
+
+	_, err := r.Pop()
+	test.Err(t, err) // Empty
+
+	for i := range 4 {
+		r.Push(i)
+	}
+
+	oldest, err := r.Pop()
+	test.Ok(t, err)
+	test.Equal(t, oldest, 1) // 0 was overwritten
+	test.Equal(t, r.Len(), 2)
+	test.False(t, r.Full())
+
+	r.Push(4)
+	test.EqualFunc(t, slices.Collect(r.All()), []int{2, 3, 4}, slices.Equal)
+}
+
+func TestGet(t *testing.T) {
+	r := ringbuffer.New[string](2)
+
+	_, err := r.Get(0)
+	test.Err(t, err) // Empty
+
+	r.Push("hello")
+	r.Push("there")
+	r.Push("general")
+
+	oldest, err := r.Get(0)
+	test.Ok(t, err)
+	test.Equal(t, oldest, "there")
+
+	newest, err := r.Get(1)
+	test.Ok(t, err)
+	test.Equal(t, newest, "general")
+
+	_, err = r.Get(2)
+	test.Err(t, err)
+
+	_, err = r.Get(-1)
+	test.Err(t, err)
+}
+
+func TestReset(t *testing.T) {
+	r := ringbuffer.New[int](2)
+	r.Push(1)
+	r.Push(2)
+	r.Push(3)
+
+	r.Reset()
+	test.True(t, r.IsEmpty())
+	test.Equal(t, r.Cap(), 2)
+
+	r.Push(4)
+	test.EqualFunc(t, slices.Collect(r.All()), []int{4}, slices.Equal)
+}
+
+func TestZeroCapacity(t *testing.T) {
+	r := ringbuffer.New[int](0)
+	r.Push(1)
+
+	test.Equal(t, r.Len(), 0)
+	test.Equal(t, r.Cap(), 0)
+}
+
+func BenchmarkPush(b *testing.B) {
+	r := ringbuffer.New[int](100)
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		r.Push(i)
+	}
+}
@@ -0,0 +1,113 @@
+package set
+
+import (
+	"iter"
+	"maps"
+)
+
+// FrozenSet is an immutable [Set], once created its contents can never change.
+//
+// Because it cannot be modified, a FrozenSet is safe to share between goroutines
+// without synchronisation. The zero value is an empty FrozenSet.
+type FrozenSet[T comparable] struct {
+	set Set[T] // The frozen set, never modified after construction
+}
+
+// Freeze returns a [FrozenSet] containing a snapshot of the items in s.
+//
+// Later modifications to s are not reflected in the returned FrozenSet. If s
+// is nil, an empty FrozenSet is returned.
+//
+//	s := set.From([]string{"hello", "there"})
+//	frozen := set.Freeze(s)
+//	s.Insert("general")
+//	frozen.Contains("general") // false
+func Freeze[T comparable](s *Set[T]) FrozenSet[T] {
+	if s == nil {
+		return FrozenSet[T]{}
+	}
+
+	return FrozenSet[T]{set: Set[T]{container: maps.Clone(s.container)}}
+}
+
+// freeze wraps s in a FrozenSet without copying, s must never be modified afterwards.
+func freeze[T comparable](s *Set[T]) FrozenSet[T] {
+	return FrozenSet[T]{set: *s}
+}
+
+// Contains reports whether the set contains item.
+func (f FrozenSet[T]) Contains(item T) bool {
+	return f.set.Contains(item)
+}
+
+// Size returns the number of items in the set.
+func (f FrozenSet[T]) Size() int {
+	return f.set.Size()
+}
+
+// IsEmpty reports whether the set is empty.
+func (f FrozenSet[T]) IsEmpty() bool {
+	return f.set.IsEmpty()
+}
+
+// All returns the an iterator over the sets items.
+//
+// The order of the items is non-deterministic, the caller should collect
+// and sort the returned items if order is important.
+func (f FrozenSet[T]) All() iter.Seq[T] {
+	return f.set.All()
+}
+
+// String implements [fmt.Stringer] for a [FrozenSet] and allows
+// it to print itself.
+func (f FrozenSet[T]) String() string {
+	return f.set.String()
+}
+
+// Thaw returns a new, mutable [Set] containing a copy of the items in the frozen set.
+func (f FrozenSet[T]) Thaw() *Set[T] {
+	thawed := WithCapacity[T](len(f.set.container))
+	maps.Copy(thawed.container, f.set.container)
+
+	return thawed
+}
+
+// Equal returns whether f and other contain exactly the same items.
+func (f FrozenSet[T]) Equal(other FrozenSet[T]) bool {
+	return Equal(&f.set, &other.set)
+}
+
+// IsSubset returns whether f is a subset of other, see [IsSubset].
+func (f FrozenSet[T]) IsSubset(other FrozenSet[T]) bool {
+	return IsSubset(&f.set, &other.set)
+}
+
+// IsSuperset returns whether f is a superset of other, see [IsSuperset].
+func (f FrozenSet[T]) IsSuperset(other FrozenSet[T]) bool {
+	return IsSuperset(&f.set, &other.set)
+}
+
+// IsDisjoint returns whether f and other have no items in common, see [IsDisjoint].
+func (f FrozenSet[T]) IsDisjoint(other FrozenSet[T]) bool {
+	return IsDisjoint(&f.set, &other.set)
+}
+
+// Union returns a new [FrozenSet] containing the items in either f or other.
+func (f FrozenSet[T]) Union(other FrozenSet[T]) FrozenSet[T] {
+	return freeze(Union(&f.set, &other.set))
+}
+
+// Intersection returns a new [FrozenSet] containing the items in both f and other.
+func (f FrozenSet[T]) Intersection(other FrozenSet[T]) FrozenSet[T] {
+	return freeze(Intersection(&f.set, &other.set))
+}
+
+// Difference returns a new [FrozenSet] containing the items in f that are not in other.
+func (f FrozenSet[T]) Difference(other FrozenSet[T]) FrozenSet[T] {
+	return freeze(Difference(&f.set, &other.set))
+}
+
+// SymmetricDifference returns a new [FrozenSet] containing the items in either f or other, but not both.
+func (f FrozenSet[T]) SymmetricDifference(other FrozenSet[T]) FrozenSet[T] {
+	return freeze(SymmetricDifference(&f.set, &other.set))
+}
@@ -0,0 +1,64 @@
+package set_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/set"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestFreeze(t *testing.T) {
+	s := set.From([]string{"hello", "there"})
+	frozen := set.Freeze(s)
+
+	test.Equal(t, frozen.Size(), 2)
+	test.True(t, frozen.Contains("hello"))
+	test.False(t, frozen.IsEmpty())
+
+	// Modifying the original should not affect the frozen set
+	s.Insert("general")
+	s.Remove("hello")
+
+	test.Equal(t, frozen.Size(), 2)
+	test.True(t, frozen.Contains("hello"))
+	test.False(t, frozen.Contains("general"))
+
+	test.EqualFunc(t, slices.Sorted(frozen.All()), []string{"hello", "there"}, slices.Equal)
+
+	// Nil and zero values should be empty
+	test.True(t, set.Freeze[string](nil).IsEmpty())
+
+	var zero set.FrozenSet[string]
+	test.True(t, zero.IsEmpty())
+	test.False(t, zero.Contains("hello"))
+}
+
+func TestThaw(t *testing.T) {
+	frozen := set.Freeze(set.From([]int{1, 2, 3}))
+
+	thawed := frozen.Thaw()
+	thawed.Insert(4)
+
+	test.Equal(t, thawed.Size(), 4)
+	test.Equal(t, frozen.Size(), 3) // Modifying the thawed set should not affect the frozen one
+}
+
+func TestFrozenOperations(t *testing.T) {
+	a := set.Freeze(set.From([]int{1, 2, 3}))
+	b := set.Freeze(set.From([]int{3, 4}))
+	c := set.Freeze(set.From([]int{1, 2}))
+
+	test.EqualFunc(t, slices.Sorted(a.Union(b).All()), []int{1, 2, 3, 4}, slices.Equal)
+	test.EqualFunc(t, slices.Sorted(a.Intersection(b).All()), []int{3}, slices.Equal)
+	test.EqualFunc(t, slices.Sorted(a.Difference(b).All()), []int{1, 2}, slices.Equal)
+	test.EqualFunc(t, slices.Sorted(a.SymmetricDifference(b).All()), []int{1, 2, 4}, slices.Equal)
+
+	test.True(t, c.IsSubset(a))
+	test.True(t, a.IsSuperset(c))
+	test.True(t, b.IsDisjoint(c))
+	test.False(t, a.IsDisjoint(b))
+
+	test.True(t, a.Equal(set.Freeze(set.From([]int{3, 2, 1}))))
+	test.False(t, a.Equal(b))
+}
@@ -1,15 +1,21 @@
 // Package set implements a simple, generic set data structure.
 //
 // The set is not safe for concurrent access across goroutines, the caller is responsible for
-// synchronising concurrent access.
+// synchronising concurrent access, or may use [SyncSet] which does this for them.
 package set
 
 import (
+	"bytes"
+	"cmp"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"iter"
 	"maps"
 	"math"
 	"slices"
+	"strings"
 )
 
 // Set is a simple, generic implementation of a mathematical set.
@@ -55,6 +61,19 @@ func From[T comparable](items []T) *Set[T] {
 	return set
 }
 
+// FromMapKeys builds a [Set] from the keys of an existing map.
+//
+// The set will be preallocated the size of len(m), a nil map results
+// in an empty set.
+func FromMapKeys[K comparable, V any](m map[K]V) *Set[K] {
+	set := WithCapacity[K](len(m))
+	for key := range m {
+		set.container[key] = struct{}{}
+	}
+
+	return set
+}
+
 // Collect builds a [Set] from an iterator of items.
 func Collect[T comparable](items iter.Seq[T]) *Set[T] {
 	set := New[T]()
@@ -92,6 +111,29 @@ func (s *Set[T]) Insert(item T) bool {
 	return true
 }
 
+// InsertMany inserts all the given items into the [Set], returning the number
+// that were newly inserted, i.e. not already present.
+//
+//	s := set.From([]string{"foo"})
+//	s.InsertMany("foo", "bar", "baz") // 2
+func (s *Set[T]) InsertMany(items ...T) int {
+	// nil safety, done once rather than per item
+	if s.container == nil {
+		s.container = make(map[T]struct{}, len(items))
+	}
+
+	inserted := 0
+
+	for _, item := range items {
+		if _, exists := s.container[item]; !exists {
+			s.container[item] = struct{}{}
+			inserted++
+		}
+	}
+
+	return inserted
+}
+
 // Contains reports whether the set contains item.
 //
 //	s := set.New[int]()
@@ -104,6 +146,62 @@ func (s *Set[T]) Contains(item T) bool {
 	return exists
 }
 
+// ContainsAll reports whether the set contains every one of items.
+//
+// It returns false as soon as an item is found to be missing, and true if
+// no items are given.
+func (s *Set[T]) ContainsAll(items ...T) bool {
+	for _, item := range items {
+		if _, exists := s.container[item]; !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContainsAny reports whether the set contains at least one of items.
+//
+// It returns true as soon as an item is found to be present, and false if
+// no items are given.
+func (s *Set[T]) ContainsAny(items ...T) bool {
+	for _, item := range items {
+		if _, exists := s.container[item]; exists {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Every reports whether pred returns true for every item in the set, stopping
+// at the first item for which it does not.
+//
+// An empty set vacuously satisfies any predicate, so Every returns true.
+func (s *Set[T]) Every(pred func(T) bool) bool {
+	for item := range s.container {
+		if !pred(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Some reports whether pred returns true for at least one item in the set, stopping
+// at the first item for which it does.
+//
+// An empty set has no items to satisfy the predicate, so Some returns false.
+func (s *Set[T]) Some(pred func(T) bool) bool {
+	for item := range s.container {
+		if pred(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Remove removes an item from the set.
 //
 // Returns whether the value was present. Removing an item
@@ -118,6 +216,176 @@ func (s *Set[T]) Remove(item T) bool {
 	return true
 }
 
+// RemoveAll removes all the given items from the set, returning the number
+// that were present and so actually removed.
+//
+// Calling RemoveAll on a nil set returns 0.
+func (s *Set[T]) RemoveAll(items ...T) int {
+	if s == nil {
+		return 0
+	}
+
+	removed := 0
+
+	for _, item := range items {
+		if _, exists := s.container[item]; exists {
+			delete(s.container, item)
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// Pop removes and returns an arbitrary item from the set, along with true. Which
+// item is returned is non-deterministic.
+//
+// If the set is empty, the zero value and false are returned.
+//
+//	s := set.From([]string{"a", "b"})
+//	for item, ok := s.Pop(); ok; item, ok = s.Pop() {
+//		// process item...
+//	}
+func (s *Set[T]) Pop() (item T, ok bool) {
+	if s == nil {
+		return item, false
+	}
+
+	for item := range s.container {
+		delete(s.container, item)
+
+		return item, true
+	}
+
+	return item, false
+}
+
+// Clear removes all the items from the set, retaining the allocated
+// memory for reuse.
+//
+//	s := set.From([]int{1, 2, 3})
+//	s.Clear()
+//	s.IsEmpty() // true
+func (s *Set[T]) Clear() {
+	clear(s.container)
+}
+
+// Clone returns a new set containing exactly the same items, modifying one
+// has no effect on the other.
+//
+// Calling Clone on a nil set returns a new empty set.
+func (s *Set[T]) Clone() *Set[T] {
+	if s == nil {
+		return New[T]()
+	}
+
+	clone := WithCapacity[T](len(s.container))
+	maps.Copy(clone.container, s.container)
+
+	return clone
+}
+
+// Grow reserves space in the set for at least n more items, at a cost of O(Size())
+// as Go maps cannot be resized in place so any existing items are copied into a new,
+// larger, backing map. It is therefore best called once, before a bulk insert.
+//
+// A zero or negative n is a no-op.
+func (s *Set[T]) Grow(n int) {
+	if n <= 0 {
+		return
+	}
+
+	grown := make(map[T]struct{}, len(s.container)+n)
+	maps.Copy(grown, s.container)
+	s.container = grown
+}
+
+// Filter returns a new set containing only the items for which keep returns true, the
+// original set is left unmodified.
+//
+// Calling Filter on a nil set returns a new empty set.
+//
+//	s := set.From([]int{1, 2, 3, 4})
+//	evens := s.Filter(func(n int) bool { return n%2 == 0 }) // {2, 4}
+func (s *Set[T]) Filter(keep func(T) bool) *Set[T] {
+	if s == nil {
+		return New[T]()
+	}
+
+	filtered := WithCapacity[T](len(s.container))
+	for item := range s.container {
+		if keep(item) {
+			// Don't need the additional checks of Insert
+			filtered.container[item] = struct{}{}
+		}
+	}
+
+	return filtered
+}
+
+// Retain removes every item from the set for which keep returns false, modifying
+// the set in place rather than allocating a new one like [Set.Filter].
+//
+// Calling Retain on a nil set is a no-op.
+func (s *Set[T]) Retain(keep func(T) bool) {
+	if s == nil {
+		return
+	}
+
+	// Deleting from a map during range is safe in Go, a deleted item that
+	// hasn't been reached yet is simply never produced
+	for item := range s.container {
+		if !keep(item) {
+			delete(s.container, item)
+		}
+	}
+}
+
+// Update inserts all the items from others into the set, i.e. it is the
+// in place equivalent of [Union]. Nil sets in others are skipped.
+func (s *Set[T]) Update(others ...*Set[T]) {
+	// nil safety
+	if s.container == nil {
+		s.container = make(map[T]struct{})
+	}
+
+	for _, other := range others {
+		if other == nil {
+			continue
+		}
+
+		maps.Copy(s.container, other.container)
+	}
+}
+
+// IntersectWith removes every item from the set that is not also in other, i.e. it
+// is the in place equivalent of [Intersection]. If other is nil, IntersectWith is a no-op.
+func (s *Set[T]) IntersectWith(other *Set[T]) {
+	if other == nil {
+		return
+	}
+
+	for item := range s.container {
+		if _, exists := other.container[item]; !exists {
+			delete(s.container, item)
+		}
+	}
+}
+
+// DifferenceWith removes every item from the set that is in any of others, i.e. it
+// is the in place equivalent of [Difference]. Nil sets in others are skipped.
+func (s *Set[T]) DifferenceWith(others ...*Set[T]) {
+	for _, other := range others {
+		if other == nil {
+			continue
+		}
+
+		for item := range other.container {
+			delete(s.container, item)
+		}
+	}
+}
+
 // Size returns the number of items currently in the set.
 //
 //	s := set.New[int]()
@@ -128,6 +396,15 @@ func (s *Set[T]) Size() int {
 	return len(s.container)
 }
 
+// Len returns the number of items currently in the set.
+//
+// It is equivalent to [Set.Size] and allows a Set to be used as a [collections.Collection].
+//
+// [collections.Collection]: https://pkg.go.dev/github.com/FollowTheProcess/collections#Collection
+func (s *Set[T]) Len() int {
+	return len(s.container)
+}
+
 // All returns the an iterator over the sets items.
 //
 // The order of the items is non-deterministic, the caller should collect
@@ -152,6 +429,193 @@ func (s *Set[T]) String() string {
 	return fmt.Sprintf("%v", slices.Collect(maps.Keys(s.container)))
 }
 
+// MarshalJSON implements [json.Marshaler] for a [Set], encoding it as a JSON array
+// of its items.
+//
+// Sets are unordered, so the order of the items in the array is non-deterministic.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	// nil safety
+	if s == nil {
+		return []byte("null"), nil
+	}
+
+	items := make([]T, 0, len(s.container))
+	for item := range s.container {
+		items = append(items, item)
+	}
+
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] for a [Set], decoding a JSON array and
+// inserting each of its items into the set.
+//
+// Items already in the set are kept, and duplicates in the array are collapsed.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return fmt.Errorf("could not decode set: %w", err)
+	}
+
+	// nil safety
+	if s.container == nil {
+		s.container = make(map[T]struct{}, len(items))
+	}
+
+	for _, item := range items {
+		s.container[item] = struct{}{}
+	}
+
+	return nil
+}
+
+// StringSet is a [Set] of strings that additionally implements [encoding.TextMarshaler] and
+// [encoding.TextUnmarshaler], so it may be used anywhere text encoding is expected, e.g. with
+// [flag.TextVar] or as a structured logging attribute.
+//
+// All the methods of [Set] are available on a StringSet, and the zero value is ready to use.
+//
+//	var tags set.StringSet[string]
+//	flag.TextVar(&tags, "tags", &tags, "Comma separated tags")
+type StringSet[T ~string] struct {
+	Set[T]
+}
+
+// MarshalText implements [encoding.TextMarshaler] for a [StringSet], encoding it as a comma
+// separated list of its items in ascending order e.g. "a,b,c".
+//
+// Commas are not escaped, so a set containing an item with a comma in it returns an error
+// rather than producing text that would not decode to the same set. Likewise for the empty
+// string, which is indistinguishable from an empty set once encoded.
+func (s *StringSet[T]) MarshalText() ([]byte, error) {
+	items := make([]string, 0, len(s.container))
+	for item := range s.container {
+		if item == "" {
+			return nil, errors.New("cannot encode empty string set item as text")
+		}
+
+		if strings.Contains(string(item), ",") {
+			return nil, fmt.Errorf("cannot encode set item %q as text: contains a comma", item)
+		}
+
+		items = append(items, string(item))
+	}
+
+	slices.Sort(items)
+
+	return []byte(strings.Join(items, ",")), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] for a [StringSet], decoding a comma
+// separated list and inserting each of its items into the set.
+//
+// Empty text decodes to no items.
+func (s *StringSet[T]) UnmarshalText(text []byte) error {
+	// nil safety
+	if s.container == nil {
+		s.container = make(map[T]struct{})
+	}
+
+	if len(text) == 0 {
+		return nil
+	}
+
+	for _, item := range strings.Split(string(text), ",") {
+		s.container[T(item)] = struct{}{}
+	}
+
+	return nil
+}
+
+// GobEncode implements [gob.GobEncoder] for a [Set], encoding its items as a slice.
+func (s *Set[T]) GobEncode() ([]byte, error) {
+	items := make([]T, 0, len(s.container))
+	for item := range s.container {
+		items = append(items, item)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(items); err != nil {
+		return nil, fmt.Errorf("could not gob encode set: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements [gob.GobDecoder] for a [Set], decoding a slice of items
+// and inserting each of them into the set.
+func (s *Set[T]) GobDecode(data []byte) error {
+	var items []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return fmt.Errorf("could not gob decode set: %w", err)
+	}
+
+	// nil safety
+	if s.container == nil {
+		s.container = make(map[T]struct{}, len(items))
+	}
+
+	for _, item := range items {
+		s.container[item] = struct{}{}
+	}
+
+	return nil
+}
+
+// Sorted returns the items in the set as a slice, sorted in ascending order.
+//
+// If the set is nil or empty, an empty (non-nil) slice is returned.
+func Sorted[T cmp.Ordered](s *Set[T]) []T {
+	if s == nil || len(s.container) == 0 {
+		return []T{}
+	}
+
+	return slices.Sorted(maps.Keys(s.container))
+}
+
+// SortedFunc returns the items in the set as a slice, sorted by the comparison
+// function compare, giving a deterministic order for any item type.
+//
+// compare should return a negative number when a < b, a positive number when
+// a > b and zero when a == b, as in [slices.SortFunc].
+//
+// If the set is nil or empty, an empty (non-nil) slice is returned.
+func SortedFunc[T comparable](s *Set[T], compare func(a, b T) int) []T {
+	if s == nil {
+		return []T{}
+	}
+
+	items := make([]T, 0, len(s.container))
+	for item := range s.container {
+		items = append(items, item)
+	}
+
+	slices.SortFunc(items, compare)
+
+	return items
+}
+
+// Map returns a new set containing the result of applying fn to every item in s.
+//
+// Because a set contains no duplicates, if fn maps two different items to the same
+// result (i.e. it is not injective) they collapse into one, so the returned set may be
+// smaller than s. Calling Map on a nil set returns a new empty set.
+//
+//	paths := set.From([]string{"main.go", "util.go", "README.md"})
+//	exts := set.Map(paths, filepath.Ext) // {".go", ".md"}
+func Map[T, U comparable](s *Set[T], fn func(T) U) *Set[U] {
+	if s == nil {
+		return New[U]()
+	}
+
+	mapped := WithCapacity[U](len(s.container))
+	for item := range s.container {
+		mapped.container[fn(item)] = struct{}{}
+	}
+
+	return mapped
+}
+
 // Equal returns whether two sets are equal to one another, i.e. they are exactly
 // the same size and contain exactly the same elements.
 //
@@ -207,6 +671,33 @@ func Union[T comparable](sets ...*Set[T]) *Set[T] {
 	return union
 }
 
+// UnionSeq returns an iterator over the combination of all the input sets, yielding each
+// distinct item exactly once.
+//
+// Unlike [Union], the result set is never built, but tracking which items have already been
+// yielded still needs O(n) auxiliary space in the number of distinct items. Nil sets are ignored.
+func UnionSeq[T comparable](sets ...*Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := New[T]()
+
+		for _, set := range sets {
+			if set == nil {
+				continue
+			}
+
+			for item := range set.container {
+				if !seen.Insert(item) {
+					continue
+				}
+
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // Intersection returns a set containing all the items present in all the input sets, without duplicates.
 func Intersection[T comparable](sets ...*Set[T]) *Set[T] {
 	if sets == nil {
@@ -295,6 +786,33 @@ func Difference[T comparable](set *Set[T], others ...*Set[T]) *Set[T] {
 	return difference
 }
 
+// DifferenceSeq returns an iterator over the items present in set that are not contained
+// in any of the others.
+//
+// Unlike [Difference], the result is computed lazily as the iterator is consumed so no
+// result set is allocated. Nil sets in others are ignored, and if set is nil the
+// iterator yields nothing.
+func DifferenceSeq[T comparable](set *Set[T], others ...*Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if set == nil {
+			return
+		}
+
+	outer:
+		for item := range set.container {
+			for _, other := range others {
+				if other != nil && other.Contains(item) {
+					continue outer
+				}
+			}
+
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
 // SymmetricDifference returns a set containing the items that are in a or in b, but not both.
 //
 // If a or b is nil, an empty set is returned. If a is an empty set, b is returned, and if b
@@ -345,8 +863,12 @@ func SymmetricDifference[T comparable](a, b *Set[T]) *Set[T] {
 
 // IsDisjoint returns whether the sets have no items in common with one another.
 //
-// It is equivalent to checking for the empty intersection but is significantly faster
+// For two sets, it is equivalent to checking for the empty intersection but is significantly faster
 // than calling [Intersection] because it does not construct the result set and does no allocation.
+//
+// For more than two sets, only the items of the smallest set are checked against the others, so
+// two of the larger sets may still share an item. Use [IsPairwiseDisjoint] to check that no
+// two sets share any item.
 func IsDisjoint[T comparable](sets ...*Set[T]) bool {
 	// Easy to handle early and guards against indexing later
 	if len(sets) == 0 {
@@ -386,6 +908,39 @@ func IsDisjoint[T comparable](sets ...*Set[T]) bool {
 	return true
 }
 
+// IsPairwiseDisjoint returns whether every pair of the sets has an empty intersection,
+// i.e. no item appears in more than one of them.
+//
+// Nil sets are skipped, and with fewer than two sets there are no pairs to share an
+// item so IsPairwiseDisjoint returns true.
+func IsPairwiseDisjoint[T comparable](sets ...*Set[T]) bool {
+	total := 0
+	for _, set := range sets {
+		if set != nil {
+			total += len(set.container)
+		}
+	}
+
+	// If every item is seen only once across all the sets, no two of them overlap
+	seen := make(map[T]struct{}, total)
+
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+
+		for item := range set.container {
+			if _, exists := seen[item]; exists {
+				return false
+			}
+
+			seen[item] = struct{}{}
+		}
+	}
+
+	return true
+}
+
 // IsSubset returns whether a is a subset of b i.e. does b contain at least
 // all the items from a.
 func IsSubset[T comparable](a, b *Set[T]) bool {
@@ -411,3 +966,53 @@ func IsSubset[T comparable](a, b *Set[T]) bool {
 func IsSuperset[T comparable](a, b *Set[T]) bool {
 	return IsSubset(b, a)
 }
+
+// IsProperSubset returns whether a is a proper subset of b i.e. does b contain
+// all the items from a, and at least one more.
+//
+// Like [IsSubset], it returns false if either set is nil or empty.
+func IsProperSubset[T comparable](a, b *Set[T]) bool {
+	return IsSubset(a, b) && len(a.container) < len(b.container)
+}
+
+// IsProperSuperset returns whether a is a proper superset of b i.e. does a contain
+// all the items from b, and at least one more.
+//
+// Like [IsSuperset], it returns false if either set is nil or empty.
+func IsProperSuperset[T comparable](a, b *Set[T]) bool {
+	return IsProperSubset(b, a)
+}
+
+// Jaccard returns the Jaccard index of a and b, a measure of their similarity between 0
+// (nothing in common) and 1 (identical), calculated as the size of their intersection divided
+// by the size of their union.
+//
+// It only counts the items the sets have in common, so neither the intersection nor the union
+// is allocated. Two empty sets are considered identical and return 1, and if either set is
+// nil, 0 is returned.
+func Jaccard[T comparable](a, b *Set[T]) float64 {
+	if a == nil || b == nil {
+		return 0
+	}
+
+	if len(a.container) == 0 && len(b.container) == 0 {
+		return 1
+	}
+
+	smaller, larger := a, b
+	if len(smaller.container) > len(larger.container) {
+		smaller, larger = larger, smaller
+	}
+
+	common := 0
+
+	for item := range smaller.container {
+		if _, exists := larger.container[item]; exists {
+			common++
+		}
+	}
+
+	union := len(a.container) + len(b.container) - common
+
+	return float64(common) / float64(union)
+}
@@ -1,7 +1,12 @@
 package set_test
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
@@ -67,6 +72,56 @@ func TestFrom(t *testing.T) {
 	})
 }
 
+func TestInsertMany(t *testing.T) {
+	s := set.From([]string{"hello"})
+
+	test.Equal(t, s.InsertMany("hello", "there", "general", "there"), 2)
+	test.True(t, set.Equal(s, set.From([]string{"hello", "there", "general"})))
+
+	test.Equal(t, s.InsertMany(), 0)
+
+	// testing nil safety
+	danger := &set.Set[int]{}
+	test.Equal(t, danger.InsertMany(1, 2, 3), 3)
+	test.Equal(t, danger.Size(), 3)
+}
+
+func TestContainsAllAny(t *testing.T) {
+	s := set.From([]string{"read", "write", "execute"})
+
+	test.True(t, s.ContainsAll("read", "write"))
+	test.False(t, s.ContainsAll("read", "delete"))
+	test.True(t, s.ContainsAll()) // Vacuously true
+
+	test.True(t, s.ContainsAny("delete", "execute"))
+	test.False(t, s.ContainsAny("delete", "admin"))
+	test.False(t, s.ContainsAny()) // Nothing to find
+
+	// testing nil safety
+	danger := &set.Set[string]{}
+	test.False(t, danger.ContainsAll("read"))
+	test.False(t, danger.ContainsAny("read"))
+}
+
+func TestEverySome(t *testing.T) {
+	s := set.From([]int{2, 4, 6, 7})
+
+	even := func(n int) bool { return n%2 == 0 }
+	positive := func(n int) bool { return n > 0 }
+	negative := func(n int) bool { return n < 0 }
+
+	test.True(t, s.Every(positive))
+	test.False(t, s.Every(even))
+
+	test.True(t, s.Some(even))
+	test.False(t, s.Some(negative))
+
+	// testing nil safety
+	danger := &set.Set[int]{}
+	test.True(t, danger.Every(negative))
+	test.False(t, danger.Some(positive))
+}
+
 func TestRemove(t *testing.T) {
 	t.Run("structs", func(t *testing.T) {
 		type person struct {
@@ -101,6 +156,267 @@ func TestRemove(t *testing.T) {
 	})
 }
 
+func TestRemoveAll(t *testing.T) {
+	s := set.From([]string{"hello", "there", "general", "kenobi"})
+
+	test.Equal(t, s.RemoveAll("hello", "general", "grievous", "hello"), 2)
+	test.True(t, set.Equal(s, set.From([]string{"there", "kenobi"})))
+
+	danger := &set.Set[string]{}
+	test.Equal(t, danger.RemoveAll("hello"), 0)
+
+	var nilSet *set.Set[string]
+	test.Equal(t, nilSet.RemoveAll("hello"), 0)
+}
+
+func TestPop(t *testing.T) {
+	s := set.From([]string{"hello", "there", "general"})
+	popped := set.New[string]()
+
+	for item, ok := s.Pop(); ok; item, ok = s.Pop() {
+		test.True(t, popped.Insert(item)) // Should never see the same item twice
+	}
+
+	test.True(t, s.IsEmpty())
+	test.True(t, set.Equal(popped, set.From([]string{"hello", "there", "general"})))
+
+	item, ok := s.Pop()
+	test.False(t, ok)
+	test.Equal(t, item, "")
+
+	var nilSet *set.Set[string]
+
+	_, ok = nilSet.Pop()
+	test.False(t, ok)
+}
+
+func TestClear(t *testing.T) {
+	s := set.From([]string{"hello", "there", "general", "kenobi"})
+	s.Clear()
+
+	test.True(t, s.IsEmpty())
+	test.Equal(t, s.Size(), 0)
+	test.False(t, s.Contains("hello"))
+
+	// Should be reusable
+	test.True(t, s.Insert("hello"))
+	test.Equal(t, s.Size(), 1)
+
+	// Clearing the zero value should be a no-op
+	danger := &set.Set[string]{}
+	danger.Clear()
+	test.True(t, danger.IsEmpty())
+}
+
+func TestClone(t *testing.T) {
+	s := set.From([]string{"hello", "there"})
+	clone := s.Clone()
+
+	test.True(t, set.Equal(s, clone))
+
+	// Mutating one should not affect the other
+	clone.Insert("general")
+	s.Remove("hello")
+
+	test.False(t, clone.Contains("hello") == s.Contains("hello"))
+	test.Equal(t, s.Size(), 1)
+	test.Equal(t, clone.Size(), 3)
+
+	var nilSet *set.Set[string]
+
+	empty := nilSet.Clone()
+	test.True(t, empty.IsEmpty())
+	test.True(t, empty.Insert("fine"))
+}
+
+func TestJSON(t *testing.T) {
+	s := set.From([]string{"hello", "there", "general", "kenobi"})
+
+	data, err := json.Marshal(s)
+	test.Ok(t, err)
+
+	var items []string
+	test.Ok(t, json.Unmarshal(data, &items))
+	test.Equal(t, len(items), 4) // Encoded as an array
+
+	danger := &set.Set[string]{}
+	test.Ok(t, json.Unmarshal(data, danger))
+	test.True(t, set.Equal(danger, s))
+
+	// Works as part of a larger struct too
+	type config struct {
+		Tags *set.Set[int] `json:"tags"`
+	}
+
+	var cfg config
+	test.Ok(t, json.Unmarshal([]byte(`{"tags": [1, 2, 2, 3]}`), &cfg))
+	test.True(t, set.Equal(cfg.Tags, set.From([]int{1, 2, 3})))
+
+	test.Err(t, json.Unmarshal([]byte(`{"not": "an array"}`), danger))
+
+	// A nil set encodes as null, like a nil slice
+	var nilSet *set.Set[int]
+	data, err = nilSet.MarshalJSON()
+	test.Ok(t, err)
+	test.Equal(t, string(data), "null")
+}
+
+func TestGrow(t *testing.T) {
+	s := set.From([]int{1, 2, 3})
+	s.Grow(100)
+
+	test.True(t, set.Equal(s, set.From([]int{1, 2, 3}))) // Items should survive
+
+	s.Grow(-1) // No-op
+	test.Equal(t, s.Size(), 3)
+
+	allocs := testing.AllocsPerRun(10, func() { s.Grow(0) })
+	test.Equal(t, allocs, 0.0) // Growing by nothing shouldn't copy
+
+	// testing nil safety
+	danger := &set.Set[int]{}
+	danger.Grow(10)
+	test.True(t, danger.Insert(1))
+}
+
+func TestFilter(t *testing.T) {
+	s := set.From([]int{1, 2, 3, 4, 5, 6})
+
+	evens := s.Filter(func(n int) bool { return n%2 == 0 })
+	test.True(t, set.Equal(evens, set.From([]int{2, 4, 6})))
+	test.Equal(t, s.Size(), 6) // Original untouched
+
+	none := s.Filter(func(int) bool { return false })
+	test.True(t, none.IsEmpty())
+
+	var nilSet *set.Set[int]
+	test.True(t, nilSet.Filter(func(int) bool { return true }).IsEmpty())
+}
+
+func TestMap(t *testing.T) {
+	paths := set.From([]string{"main.go", "util.go", "README.md"})
+
+	exts := set.Map(paths, filepath.Ext)
+	test.True(t, set.Equal(exts, set.From([]string{".go", ".md"}))) // Collisions collapse
+
+	lengths := set.Map(set.From([]string{"a", "bb", "ccc"}), func(s string) int { return len(s) })
+	test.True(t, set.Equal(lengths, set.From([]int{1, 2, 3})))
+
+	test.True(t, set.Map(nil, filepath.Ext).IsEmpty())
+}
+
+func TestRetain(t *testing.T) {
+	s := set.From([]int{1, 2, 3, 4, 5, 6})
+
+	s.Retain(func(n int) bool { return n%2 == 0 })
+	test.True(t, set.Equal(s, set.From([]int{2, 4, 6})))
+
+	s.Retain(func(int) bool { return false })
+	test.True(t, s.IsEmpty())
+
+	var nilSet *set.Set[int]
+	nilSet.Retain(func(int) bool { return false }) // Should not panic
+}
+
+func TestUpdate(t *testing.T) {
+	s := set.From([]string{"hello"})
+	s.Update(set.From([]string{"there", "hello"}), nil, set.From([]string{"general"}))
+
+	test.True(t, set.Equal(s, set.From([]string{"hello", "there", "general"})))
+
+	// testing nil safety
+	danger := &set.Set[string]{}
+	danger.Update(s)
+	test.True(t, set.Equal(danger, s))
+}
+
+func TestIntersectWith(t *testing.T) {
+	s := set.From([]int{1, 2, 3, 4})
+
+	s.IntersectWith(nil) // No-op
+	test.Equal(t, s.Size(), 4)
+
+	s.IntersectWith(set.From([]int{2, 4, 6}))
+	test.True(t, set.Equal(s, set.From([]int{2, 4})))
+
+	s.IntersectWith(set.New[int]())
+	test.True(t, s.IsEmpty())
+}
+
+func TestDifferenceWith(t *testing.T) {
+	s := set.From([]int{1, 2, 3, 4, 5})
+	s.DifferenceWith(set.From([]int{1, 2}), nil, set.From([]int{5, 6}))
+
+	test.True(t, set.Equal(s, set.From([]int{3, 4})))
+
+	danger := &set.Set[int]{}
+	danger.DifferenceWith(s) // Should not panic
+	test.True(t, danger.IsEmpty())
+}
+
+func TestStringSet(t *testing.T) {
+	s := &set.StringSet[string]{}
+	s.Insert("there")
+	s.Insert("hello")
+	s.Insert("general")
+
+	text, err := s.MarshalText()
+	test.Ok(t, err)
+	test.Equal(t, string(text), "general,hello,there") // Sorted
+
+	decoded := &set.StringSet[string]{}
+	test.Ok(t, decoded.UnmarshalText(text))
+	test.True(t, set.Equal(&decoded.Set, &s.Set))
+
+	empty := &set.StringSet[string]{}
+	test.Ok(t, empty.UnmarshalText([]byte("")))
+	test.True(t, empty.IsEmpty())
+
+	empty.Insert("a,b")
+	_, err = empty.MarshalText()
+	test.Err(t, err) // Commas can't round trip
+
+	blank := &set.StringSet[string]{}
+	blank.Insert("")
+	_, err = blank.MarshalText()
+	test.Err(t, err) // Would encode the same as an empty set
+
+	type colour string
+
+	colours := &set.StringSet[colour]{}
+	test.Ok(t, colours.UnmarshalText([]byte("red,blue")))
+	test.True(t, colours.Contains("red"))
+
+	text, err = colours.MarshalText()
+	test.Ok(t, err)
+	test.Equal(t, string(text), "blue,red")
+
+	// Plain sets must not claim to be text encodable
+	_, ok := any(set.New[int]()).(encoding.TextMarshaler)
+	test.False(t, ok)
+}
+
+func TestGob(t *testing.T) {
+	s := set.From([]int{1, 2, 3, 4, 5})
+
+	buf := &bytes.Buffer{}
+	test.Ok(t, gob.NewEncoder(buf).Encode(s))
+
+	decoded := set.New[int]()
+	test.Ok(t, gob.NewDecoder(buf).Decode(decoded))
+	test.True(t, set.Equal(decoded, s))
+
+	// testing nil safety
+	buf.Reset()
+	test.Ok(t, gob.NewEncoder(buf).Encode(s))
+
+	danger := &set.Set[int]{}
+	test.Ok(t, gob.NewDecoder(buf).Decode(danger))
+	test.True(t, set.Equal(danger, s))
+
+	test.Err(t, danger.GobDecode([]byte("not gob")))
+}
+
 func TestItems(t *testing.T) {
 	items := []string{"cheese", "apples", "oranges", "milk"}
 	slices.Sort(items)
@@ -110,6 +426,19 @@ func TestItems(t *testing.T) {
 	test.EqualFunc(t, got, items, slices.Equal)
 }
 
+func TestFromMapKeys(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2, "three": 3}
+
+	s := set.FromMapKeys(m)
+	test.True(t, set.Equal(s, set.From([]string{"one", "two", "three"})))
+
+	var nilMap map[string]int
+
+	empty := set.FromMapKeys(nilMap)
+	test.True(t, empty.IsEmpty())
+	test.True(t, empty.Insert("fine"))
+}
+
 func TestCollect(t *testing.T) {
 	items := []string{"cheese", "apples", "oranges", "milk"}
 	slices.Sort(items)
@@ -120,6 +449,42 @@ func TestCollect(t *testing.T) {
 	test.EqualFunc(t, got, items, slices.Equal)
 }
 
+func TestSorted(t *testing.T) {
+	s := set.From([]string{"there", "kenobi", "hello", "general"})
+	test.EqualFunc(t, set.Sorted(s), []string{"general", "hello", "kenobi", "there"}, slices.Equal)
+
+	empty := set.Sorted(set.New[int]())
+	test.True(t, empty != nil)
+	test.Equal(t, len(empty), 0)
+
+	test.True(t, set.Sorted[int](nil) != nil)
+}
+
+func TestSortedFunc(t *testing.T) {
+	type point struct {
+		x, y int
+	}
+
+	s := set.From([]point{{x: 2, y: 1}, {x: 1, y: 2}, {x: 1, y: 1}})
+
+	byXThenY := func(a, b point) int {
+		if a.x != b.x {
+			return a.x - b.x
+		}
+
+		return a.y - b.y
+	}
+
+	got := set.SortedFunc(s, byXThenY)
+	test.EqualFunc(t, got, []point{{x: 1, y: 1}, {x: 1, y: 2}, {x: 2, y: 1}}, slices.Equal)
+
+	empty := set.SortedFunc(set.New[point](), byXThenY)
+	test.True(t, empty != nil)
+	test.Equal(t, len(empty), 0)
+
+	test.True(t, set.SortedFunc(nil, byXThenY) != nil)
+}
+
 func TestEqual(t *testing.T) {
 	tests := []struct {
 		a, b *set.Set[string] // Sets the compare
@@ -226,6 +591,26 @@ func TestUnion(t *testing.T) {
 	}
 }
 
+func TestUnionSeq(t *testing.T) {
+	a := set.From([]string{"hello", "there"})
+	b := set.From([]string{"there", "general", "kenobi"})
+
+	got := slices.Collect(set.UnionSeq(a, nil, b))
+	test.Equal(t, len(got), 4) // Each item exactly once
+	test.True(t, set.Equal(set.From(got), set.Union(a, b)))
+
+	test.Equal(t, len(slices.Collect(set.UnionSeq[string]())), 0)
+
+	// Early return should stop the walk
+	count := 0
+	for range set.UnionSeq(a, b) {
+		count++
+		break
+	}
+
+	test.Equal(t, count, 1)
+}
+
 func TestIntersection(t *testing.T) {
 	tests := []struct {
 		want *set.Set[string]   // The expected intersection set
@@ -341,6 +726,33 @@ func TestDifference(t *testing.T) {
 	}
 }
 
+func TestDifferenceSeq(t *testing.T) {
+	target := set.From([]string{"hello", "there", "general", "kenobi"})
+	others := []*set.Set[string]{
+		set.From([]string{"hello", "to", "you"}),
+		nil, // Should be ignored
+		set.From([]string{"general", "grievous"}),
+	}
+
+	got := set.Collect(set.DifferenceSeq(target, others...))
+	test.True(t, set.Equal(got, set.Difference(target, others[0], others[2])))
+
+	// No others yields everything
+	test.True(t, set.Equal(set.Collect(set.DifferenceSeq(target)), target))
+
+	// Nil target yields nothing
+	test.Equal(t, len(slices.Collect(set.DifferenceSeq[string](nil, target))), 0)
+
+	// Early return should stop the walk
+	count := 0
+	for range set.DifferenceSeq(target) {
+		count++
+		break
+	}
+
+	test.Equal(t, count, 1)
+}
+
 func TestIsDisjoint(t *testing.T) {
 	tests := []struct {
 		name string          // Name of the test case
@@ -402,6 +814,50 @@ func TestIsDisjoint(t *testing.T) {
 	}
 }
 
+func TestIsPairwiseDisjoint(t *testing.T) {
+	tests := []struct {
+		name string             // Name of the test case
+		sets []*set.Set[string] // The sets to check
+		want bool               // Expected answer
+	}{
+		{
+			name: "no sets",
+			sets: nil,
+			want: true,
+		},
+		{
+			name: "one set",
+			sets: []*set.Set[string]{set.From([]string{"one"})},
+			want: true,
+		},
+		{
+			name: "disjoint",
+			sets: []*set.Set[string]{
+				set.From([]string{"one", "two"}),
+				set.From([]string{"three"}),
+				nil,
+				set.From([]string{"four", "five"}),
+			},
+			want: true,
+		},
+		{
+			name: "nothing common to all but one pair overlaps",
+			sets: []*set.Set[string]{
+				set.From([]string{"one"}),
+				set.From([]string{"two", "three"}),
+				set.From([]string{"three", "four"}),
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.Equal(t, set.IsPairwiseDisjoint(tt.sets...), tt.want)
+		})
+	}
+}
+
 func TestIsSubset(t *testing.T) {
 	tests := []struct {
 		a, b *set.Set[string] // The sets to compare
@@ -504,6 +960,90 @@ func TestIsSuperset(t *testing.T) {
 	}
 }
 
+func TestIsProperSubset(t *testing.T) {
+	tests := []struct {
+		a, b *set.Set[string] // The sets to compare
+		name string           // Name of the test case
+		want bool             // Expected answer
+	}{
+		{
+			name: "nil",
+			a:    nil,
+			b:    nil,
+			want: false,
+		},
+		{
+			name: "both empty",
+			a:    set.New[string](),
+			b:    set.New[string](),
+			want: false,
+		},
+		{
+			name: "equal",
+			a:    set.From([]string{"one", "two"}),
+			b:    set.From([]string{"one", "two"}),
+			want: false,
+		},
+		{
+			name: "not a subset",
+			a:    set.From([]string{"one", "two"}),
+			b:    set.From([]string{"zero", "one", "three"}),
+			want: false,
+		},
+		{
+			name: "valid proper subset",
+			a:    set.From([]string{"one", "two"}),
+			b:    set.From([]string{"zero", "one", "two"}),
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.Equal(t, set.IsProperSubset(tt.a, tt.b), tt.want)
+		})
+	}
+}
+
+func TestIsProperSuperset(t *testing.T) {
+	tests := []struct {
+		a, b *set.Set[string] // The sets to compare
+		name string           // Name of the test case
+		want bool             // Expected answer
+	}{
+		{
+			name: "nil",
+			a:    nil,
+			b:    nil,
+			want: false,
+		},
+		{
+			name: "equal",
+			a:    set.From([]string{"one", "two"}),
+			b:    set.From([]string{"one", "two"}),
+			want: false,
+		},
+		{
+			name: "not a superset",
+			a:    set.From([]string{"one", "two"}),
+			b:    set.From([]string{"zero", "one"}),
+			want: false,
+		},
+		{
+			name: "valid proper superset",
+			a:    set.From([]string{"zero", "one", "two"}),
+			b:    set.From([]string{"one", "two"}),
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.Equal(t, set.IsProperSuperset(tt.a, tt.b), tt.want)
+		})
+	}
+}
+
 func TestSymmetricDifference(t *testing.T) {
 	tests := []struct {
 		a, b *set.Set[string] // The sets to compare
@@ -753,3 +1293,49 @@ func BenchmarkSymmetricDifference(b *testing.B) {
 		set.SymmetricDifference(s1, s2)
 	}
 }
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		a, b *set.Set[string] // The sets to compare
+		name string           // Name of the test case
+		want float64          // Expected index
+	}{
+		{
+			name: "nil",
+			a:    nil,
+			b:    set.From([]string{"one"}),
+			want: 0,
+		},
+		{
+			name: "both empty",
+			a:    set.New[string](),
+			b:    set.New[string](),
+			want: 1,
+		},
+		{
+			name: "identical",
+			a:    set.From([]string{"one", "two", "three"}),
+			b:    set.From([]string{"one", "two", "three"}),
+			want: 1,
+		},
+		{
+			name: "disjoint",
+			a:    set.From([]string{"one", "two"}),
+			b:    set.From([]string{"three", "four"}),
+			want: 0,
+		},
+		{
+			name: "partial overlap",
+			a:    set.From([]string{"one", "two", "three"}),
+			b:    set.From([]string{"two", "three", "four", "five"}),
+			want: 2.0 / 5.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.NearlyEqual(t, set.Jaccard(tt.a, tt.b), tt.want)
+			test.NearlyEqual(t, set.Jaccard(tt.b, tt.a), tt.want) // Symmetric
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Small is a set of ordered items backed by a sorted slice rather than a map.
+//
+// Membership is a binary search so operations are O(log n) lookups with O(n) insertion and
+// removal, but for a handful of items this avoids the allocation and hashing overhead of a map
+// and benefits from the slice being contiguous in memory. It is a good fit when very large
+// numbers of tiny sets are created; from benchmarking, it allocates far less memory than [Set]
+// and is as fast or faster up to a few dozen items. Because insertion and removal shift the
+// slice, a [Set] should be preferred for anything larger.
+//
+// The zero value is an empty set ready to use.
+type Small[T cmp.Ordered] struct {
+	items []T // The items in the set, always sorted in ascending order
+}
+
+// NewSmall builds and returns a new empty [Small] set.
+func NewSmall[T cmp.Ordered]() *Small[T] {
+	return &Small[T]{}
+}
+
+// Insert inserts an item into the set.
+//
+// Returns whether the item was newly inserted, inserting an item that is
+// already present is a no-op.
+func (s *Small[T]) Insert(item T) bool {
+	index, found := slices.BinarySearch(s.items, item)
+	if found {
+		return false
+	}
+
+	s.items = slices.Insert(s.items, index, item)
+
+	return true
+}
+
+// Contains reports whether the set contains item.
+func (s *Small[T]) Contains(item T) bool {
+	_, found := slices.BinarySearch(s.items, item)
+
+	return found
+}
+
+// Remove removes an item from the set.
+//
+// Returns whether the value was present, removing an item
+// that wasn't in the set is a no-op.
+func (s *Small[T]) Remove(item T) bool {
+	index, found := slices.BinarySearch(s.items, item)
+	if !found {
+		return false
+	}
+
+	s.items = slices.Delete(s.items, index, index+1)
+
+	return true
+}
+
+// Size returns the number of items currently in the set.
+func (s *Small[T]) Size() int {
+	return len(s.items)
+}
+
+// Len returns the number of items currently in the set, it is equivalent to [Small.Size].
+func (s *Small[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty reports whether the set is empty.
+func (s *Small[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// All returns an iterator over the items in the set, in ascending order.
+func (s *Small[T]) All() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// String implements [fmt.Stringer] for a [Small] set and allows
+// it to print itself.
+func (s *Small[T]) String() string {
+	return fmt.Sprintf("%v", s.items)
+}
@@ -0,0 +1,80 @@
+package set_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/set"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestSmall(t *testing.T) {
+	s := set.NewSmall[string]()
+	test.True(t, s.IsEmpty())
+
+	test.True(t, s.Insert("kenobi"))
+	test.True(t, s.Insert("hello"))
+	test.True(t, s.Insert("there"))
+	test.False(t, s.Insert("hello")) // Already present
+
+	test.Equal(t, s.Size(), 3)
+	test.Equal(t, s.Len(), 3)
+	test.True(t, s.Contains("there"))
+	test.False(t, s.Contains("general"))
+
+	test.EqualFunc(t, slices.Collect(s.All()), []string{"hello", "kenobi", "there"}, slices.Equal)
+	test.Equal(t, s.String(), "[hello kenobi there]")
+
+	test.True(t, s.Remove("kenobi"))
+	test.False(t, s.Remove("kenobi"))
+	test.EqualFunc(t, slices.Collect(s.All()), []string{"hello", "there"}, slices.Equal)
+
+	// Zero value should be usable
+	var zero set.Small[int]
+	test.False(t, zero.Contains(1))
+	test.False(t, zero.Remove(1))
+	test.True(t, zero.Insert(1))
+}
+
+// Sinks for the benchmarks so the sets escape to the heap as they would in real use.
+var (
+	smallSink *set.Small[int]
+	mapSink   *set.Set[int]
+)
+
+// BenchmarkSmallVsMap compares building and querying a Small set against a map backed
+// Set at a range of sizes, to justify the threshold in the Small docs.
+func BenchmarkSmallVsMap(b *testing.B) {
+	for _, size := range []int{2, 8, 32} {
+		b.Run(fmt.Sprintf("small/%d", size), func(b *testing.B) {
+			for range b.N {
+				s := set.NewSmall[int]()
+				for i := range size {
+					s.Insert(i)
+				}
+
+				for i := range size {
+					s.Contains(i)
+				}
+
+				smallSink = s
+			}
+		})
+
+		b.Run(fmt.Sprintf("map/%d", size), func(b *testing.B) {
+			for range b.N {
+				s := set.New[int]()
+				for i := range size {
+					s.Insert(i)
+				}
+
+				for i := range size {
+					s.Contains(i)
+				}
+
+				mapSink = s
+			}
+		})
+	}
+}
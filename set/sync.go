@@ -0,0 +1,90 @@
+package set
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// SyncSet is a [Set] that is safe for concurrent access across goroutines.
+//
+// Reads take a shared read lock and writes take an exclusive write lock, the zero
+// value is an empty set ready to use.
+type SyncSet[T comparable] struct {
+	set Set[T]       // The wrapped set
+	mu  sync.RWMutex // Guards set
+}
+
+// NewSync builds and returns a new empty [SyncSet].
+func NewSync[T comparable]() *SyncSet[T] {
+	return &SyncSet[T]{
+		set: Set[T]{container: make(map[T]struct{})},
+	}
+}
+
+// Insert inserts an item into the set, returning whether the item was
+// newly inserted.
+func (s *SyncSet[T]) Insert(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Insert(item)
+}
+
+// Remove removes an item from the set, returning whether the value
+// was present.
+func (s *SyncSet[T]) Remove(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Remove(item)
+}
+
+// Clear removes all the items from the set, retaining the allocated
+// memory for reuse.
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Clear()
+}
+
+// Contains reports whether the set contains item.
+func (s *SyncSet[T]) Contains(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Contains(item)
+}
+
+// Size returns the number of items currently in the set.
+func (s *SyncSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Size()
+}
+
+// IsEmpty reports whether the set is empty.
+func (s *SyncSet[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.IsEmpty()
+}
+
+// All returns an iterator over a snapshot of the set's items, taken at
+// the time All is called.
+//
+// The lock is not held during iteration, so the set may be freely modified
+// while iterating, but those modifications will not be reflected in the iterator.
+//
+// The order of the items is non-deterministic.
+func (s *SyncSet[T]) All() iter.Seq[T] {
+	s.mu.RLock()
+	snapshot := slices.Collect(maps.Keys(s.set.container))
+	s.mu.RUnlock()
+
+	return slices.Values(snapshot)
+}
@@ -0,0 +1,80 @@
+package set_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/set"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestSyncSet(t *testing.T) {
+	s := set.NewSync[int]()
+	test.True(t, s.IsEmpty())
+
+	test.True(t, s.Insert(1))
+	test.False(t, s.Insert(1))
+	test.True(t, s.Contains(1))
+	test.Equal(t, s.Size(), 1)
+
+	test.True(t, s.Remove(1))
+	test.False(t, s.Remove(1))
+	test.True(t, s.IsEmpty())
+}
+
+func TestSyncSetClear(t *testing.T) {
+	s := set.NewSync[string]()
+	s.Insert("hello")
+	s.Insert("there")
+
+	s.Clear()
+	test.True(t, s.IsEmpty())
+
+	// Zero value should be fine too
+	var zero set.SyncSet[string]
+
+	zero.Clear()
+	test.True(t, zero.Insert("hello"))
+}
+
+func TestSyncSetConcurrent(t *testing.T) {
+	s := set.NewSync[int]()
+
+	var wg sync.WaitGroup
+
+	for i := range 100 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			s.Insert(i)
+			s.Contains(i)
+			s.Size()
+
+			for range s.All() {
+				// Modifying during iteration should not deadlock
+				s.Insert(i + 1000)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for i := range 100 {
+		test.True(t, s.Contains(i))
+	}
+}
+
+func TestSyncSetSnapshot(t *testing.T) {
+	var s set.SyncSet[string] // Zero value should be usable
+	s.Insert("one")
+	s.Insert("two")
+
+	snapshot := s.All()
+
+	s.Insert("three")
+
+	test.EqualFunc(t, slices.Sorted(snapshot), []string{"one", "two"}, slices.Equal)
+}
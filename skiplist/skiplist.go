@@ -0,0 +1,256 @@
+// Package skiplist implements a skip list, an ordered map offering probabilistic O(log n)
+// insertion, lookup and removal.
+//
+// A skip list is a sorted linked list with additional "express lanes" of links that skip
+// over many nodes at once. How many lanes each node takes part in is chosen at random, so
+// unlike a balanced tree there is no rebalancing to do, making it simpler to implement while
+// giving the same expected performance.
+//
+// The skip list is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	// maxLevel is the maximum number of levels a node can take part in, with p = 1/4
+	// this comfortably supports 4^32 entries.
+	maxLevel = 32
+
+	// branching is the inverse of the probability that a node at one level is also
+	// promoted to the level above.
+	branching = 4
+)
+
+// node is a single entry in the skip list.
+type node[K cmp.Ordered, V any] struct {
+	next  []*node[K, V] // The next node at each level this node takes part in
+	key   K             // The key
+	value V             // The value
+}
+
+// SkipList is an ordered map backed by a skip list.
+type SkipList[K cmp.Ordered, V any] struct {
+	head  *node[K, V] // Sentinel node, its next pointers are the start of each level
+	rng   *rand.Rand  // Source of randomness for node levels, nil means the global source
+	level int         // The number of levels currently in use
+	size  int         // The number of entries in the skip list
+}
+
+// New creates and returns a new, empty [SkipList].
+//
+// Node levels are chosen using the automatically seeded global random source
+// from [math/rand/v2].
+func New[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  &node[K, V]{next: make([]*node[K, V], maxLevel)},
+		level: 1,
+	}
+}
+
+// NewWithSource creates and returns a new, empty [SkipList] that uses src to choose
+// node levels.
+//
+// This is mainly useful for getting a deterministic structure in tests:
+//
+//	s := skiplist.NewWithSource[int, string](rand.NewPCG(1, 2))
+func NewWithSource[K cmp.Ordered, V any](src rand.Source) *SkipList[K, V] {
+	s := New[K, V]()
+	s.rng = rand.New(src)
+
+	return s
+}
+
+// Insert inserts a new value into the skip list against the given key, returning the previous
+// value and a boolean to indicate presence.
+//
+// If the skip list did not have this key present before the call to Insert, it will return the
+// value just inserted and false.
+//
+// If the skip list did have this key, and this call to Insert is therefore an update of an existing value,
+// then the old value and true are returned.
+func (s *SkipList[K, V]) Insert(key K, value V) (val V, existed bool) {
+	s.init()
+
+	var update [maxLevel]*node[K, V]
+
+	if found := s.search(key, &update); found != nil {
+		old := found.value
+		found.value = value
+
+		return old, true
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+		}
+
+		s.level = level
+	}
+
+	n := &node[K, V]{
+		next:  make([]*node[K, V], level),
+		key:   key,
+		value: value,
+	}
+
+	for i := range level {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+
+	s.size++
+
+	return value, false
+}
+
+// Get returns the value stored against the given key in the skip list and a boolean
+// to indicate presence, like the standard Go map.
+func (s *SkipList[K, V]) Get(key K) (value V, ok bool) {
+	if s.head == nil {
+		return value, false
+	}
+
+	if found := s.search(key, nil); found != nil {
+		return found.value, true
+	}
+
+	return value, false
+}
+
+// Contains reports whether the skip list contains the given key.
+func (s *SkipList[K, V]) Contains(key K) bool {
+	_, ok := s.Get(key)
+
+	return ok
+}
+
+// Remove removes a key from the skip list, returning the stored value and
+// a boolean to indicate whether it was there to begin with.
+func (s *SkipList[K, V]) Remove(key K) (value V, existed bool) {
+	if s.head == nil {
+		return value, false
+	}
+
+	var update [maxLevel]*node[K, V]
+
+	found := s.search(key, &update)
+	if found == nil {
+		return value, false
+	}
+
+	for i := range len(found.next) {
+		update[i].next[i] = found.next[i]
+	}
+
+	// Drop any levels that are now empty
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.size--
+
+	return found.value, true
+}
+
+// Size returns the number of entries in the skip list.
+func (s *SkipList[K, V]) Size() int {
+	return s.size
+}
+
+// All returns an iterator over the entries in the skip list in ascending order of key.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if s.head == nil {
+			return
+		}
+
+		for current := s.head.next[0]; current != nil; current = current.next[0] {
+			if !yield(current.key, current.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries in the skip list whose keys are in the half
+// open interval [lo, hi), in ascending order of key.
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if s.head == nil {
+			return
+		}
+
+		// Find the last node before lo using the express lanes, then walk the bottom level
+		current := s.head
+		for i := s.level - 1; i >= 0; i-- {
+			for current.next[i] != nil && cmp.Less(current.next[i].key, lo) {
+				current = current.next[i]
+			}
+		}
+
+		for current = current.next[0]; current != nil && cmp.Less(current.key, hi); current = current.next[0] {
+			if !yield(current.key, current.value) {
+				return
+			}
+		}
+	}
+}
+
+// init ensures the skip list is ready to use, allowing the zero value to be used.
+func (s *SkipList[K, V]) init() {
+	if s.head == nil {
+		s.head = &node[K, V]{next: make([]*node[K, V], maxLevel)}
+		s.level = 1
+	}
+}
+
+// search returns the node with the given key, or nil if there isn't one.
+//
+// If update is not nil, it is filled with the last node before key at each level,
+// i.e. the nodes whose next pointers would need changing to insert or remove key.
+func (s *SkipList[K, V]) search(key K, update *[maxLevel]*node[K, V]) *node[K, V] {
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && cmp.Less(current.next[i].key, key) {
+			current = current.next[i]
+		}
+
+		if update != nil {
+			update[i] = current
+		}
+	}
+
+	current = current.next[0]
+	if current != nil && cmp.Compare(current.key, key) == 0 {
+		return current
+	}
+
+	return nil
+}
+
+// randomLevel returns a random level for a new node, each level being
+// 1/branching as likely as the one below it.
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < maxLevel && s.uint32()%branching == 0 {
+		level++
+	}
+
+	return level
+}
+
+// uint32 returns a random uint32 from the skip list's source.
+func (s *SkipList[K, V]) uint32() uint32 {
+	if s.rng == nil {
+		return rand.Uint32() //nolint: gosec // Not used for anything security related
+	}
+
+	return s.rng.Uint32()
+}
@@ -0,0 +1,125 @@
+package skiplist_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/skiplist"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestInsertGet(t *testing.T) {
+	s := skiplist.New[string, int]()
+	test.Equal(t, s.Size(), 0)
+
+	_, existed := s.Insert("two", 2)
+	test.False(t, existed)
+
+	s.Insert("one", 1)
+	s.Insert("three", 3)
+
+	old, existed := s.Insert("two", 22)
+	test.True(t, existed)
+	test.Equal(t, old, 2)
+
+	test.Equal(t, s.Size(), 3)
+
+	value, ok := s.Get("two")
+	test.True(t, ok)
+	test.Equal(t, value, 22)
+
+	_, ok = s.Get("four")
+	test.False(t, ok)
+
+	test.True(t, s.Contains("one"))
+	test.False(t, s.Contains("four"))
+}
+
+func TestRemove(t *testing.T) {
+	s := skiplist.NewWithSource[int, int](rand.NewPCG(1, 2))
+	for i := range 100 {
+		s.Insert(i, i*i)
+	}
+
+	for i := 0; i < 100; i += 2 {
+		value, existed := s.Remove(i)
+		test.True(t, existed)
+		test.Equal(t, value, i*i)
+	}
+
+	_, existed := s.Remove(0)
+	test.False(t, existed)
+	test.Equal(t, s.Size(), 50)
+
+	for i := range 100 {
+		test.Equal(t, s.Contains(i), i%2 != 0)
+	}
+}
+
+func TestAll(t *testing.T) {
+	s := skiplist.NewWithSource[int, string](rand.NewPCG(1, 2))
+
+	keys := rand.New(rand.NewPCG(3, 4)).Perm(500)
+	for _, key := range keys {
+		s.Insert(key, "")
+	}
+
+	var got []int
+	for key := range s.All() {
+		got = append(got, key)
+	}
+
+	test.Equal(t, len(got), 500)
+	test.True(t, slices.IsSorted(got))
+
+	// Early return
+	for range s.All() {
+		break
+	}
+}
+
+func TestRange(t *testing.T) {
+	s := skiplist.New[int, int]()
+	for i := range 20 {
+		s.Insert(i*2, i)
+	}
+
+	var got []int
+	for key := range s.Range(5, 13) {
+		got = append(got, key)
+	}
+
+	test.EqualFunc(t, got, []int{6, 8, 10, 12}, slices.Equal)
+
+	got = nil
+	for key := range s.Range(100, 200) {
+		got = append(got, key)
+	}
+
+	test.Equal(t, len(got), 0)
+}
+
+func TestNotNew(t *testing.T) {
+	s := skiplist.SkipList[string, int]{}
+
+	_, ok := s.Get("missing")
+	test.False(t, ok)
+
+	_, existed := s.Remove("missing")
+	test.False(t, existed)
+
+	s.Insert("one", 1)
+
+	value, ok := s.Get("one")
+	test.True(t, ok)
+	test.Equal(t, value, 1)
+}
+
+func BenchmarkInsert(b *testing.B) {
+	s := skiplist.New[int, int]()
+
+	for i := range b.N {
+		s.Insert(i, i)
+	}
+}
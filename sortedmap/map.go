@@ -0,0 +1,316 @@
+// Package sortedmap implements a sorted map, that is; a map whose entries are kept in
+// order of their keys.
+//
+// The map is backed by a balanced (AVL) binary search tree, so insertion, lookup and removal
+// are all O(log n) and iteration in key order is cheap, making it well suited to range queries.
+//
+// The map is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package sortedmap
+
+import (
+	"cmp"
+	"iter"
+)
+
+// node is a single node in the tree.
+type node[K cmp.Ordered, V any] struct {
+	left   *node[K, V] // Subtree of keys less than this one
+	right  *node[K, V] // Subtree of keys greater than this one
+	key    K           // The key
+	value  V           // The value
+	height int         // Height of the subtree rooted at this node, a leaf has height 1
+}
+
+// SortedMap is a map whose entries are kept sorted by key.
+type SortedMap[K cmp.Ordered, V any] struct {
+	root *node[K, V] // The root of the tree
+	size int         // The number of entries in the map
+}
+
+// New creates and returns a new, empty [SortedMap].
+func New[K cmp.Ordered, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{}
+}
+
+// Insert inserts a new value into the map against the given key, returning the previous
+// value and a boolean to indicate presence.
+//
+// If the map did not have this key present before the call to Insert, it will return the
+// value just inserted and false.
+//
+// If the map did have this key, and this call to Insert is therefore an update of an existing value,
+// then the old value and true are returned.
+func (m *SortedMap[K, V]) Insert(key K, value V) (val V, existed bool) {
+	m.root, val, existed = insert(m.root, key, value)
+	if !existed {
+		m.size++
+	}
+
+	return val, existed
+}
+
+// Get returns the value stored against the given key in the map and a boolean
+// to indicate presence, like the standard Go map.
+func (m *SortedMap[K, V]) Get(key K) (value V, ok bool) {
+	current := m.root
+	for current != nil {
+		switch cmp.Compare(key, current.key) {
+		case -1:
+			current = current.left
+		case 1:
+			current = current.right
+		default:
+			return current.value, true
+		}
+	}
+
+	return value, false
+}
+
+// Contains reports whether the map contains the given key.
+func (m *SortedMap[K, V]) Contains(key K) bool {
+	_, ok := m.Get(key)
+
+	return ok
+}
+
+// Remove removes a key from the map, returning the stored value and
+// a boolean to indicate whether it was in the map to begin with.
+func (m *SortedMap[K, V]) Remove(key K) (value V, existed bool) {
+	m.root, value, existed = remove(m.root, key)
+	if existed {
+		m.size--
+	}
+
+	return value, existed
+}
+
+// Size returns the number of entries in the map.
+func (m *SortedMap[K, V]) Size() int {
+	return m.size
+}
+
+// Min returns the entry with the smallest key, and false if the map is empty.
+func (m *SortedMap[K, V]) Min() (key K, value V, ok bool) {
+	if m.root == nil {
+		return key, value, false
+	}
+
+	smallest := leftmost(m.root)
+
+	return smallest.key, smallest.value, true
+}
+
+// Max returns the entry with the largest key, and false if the map is empty.
+func (m *SortedMap[K, V]) Max() (key K, value V, ok bool) {
+	if m.root == nil {
+		return key, value, false
+	}
+
+	largest := m.root
+	for largest.right != nil {
+		largest = largest.right
+	}
+
+	return largest.key, largest.value, true
+}
+
+// All returns an iterator over the entries in the map in ascending order of key.
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(m.root, yield)
+	}
+}
+
+// Keys returns an iterator over the keys in the map in ascending order.
+func (m *SortedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range m.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in the map in ascending order of key.
+func (m *SortedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range m.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries in the map whose keys are in the half
+// open interval [lo, hi), in ascending order of key.
+//
+// Subtrees entirely outside of the interval are never visited.
+func (m *SortedMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walkRange(m.root, lo, hi, yield)
+	}
+}
+
+// insert inserts key, value into the subtree rooted at n, returning the new root
+// of the (rebalanced) subtree.
+func insert[K cmp.Ordered, V any](n *node[K, V], key K, value V) (root *node[K, V], val V, existed bool) {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, height: 1}, value, false
+	}
+
+	switch cmp.Compare(key, n.key) {
+	case -1:
+		n.left, val, existed = insert(n.left, key, value)
+	case 1:
+		n.right, val, existed = insert(n.right, key, value)
+	default:
+		old := n.value
+		n.value = value
+
+		return n, old, true
+	}
+
+	return rebalance(n), val, existed
+}
+
+// remove removes key from the subtree rooted at n, returning the new root
+// of the (rebalanced) subtree.
+func remove[K cmp.Ordered, V any](n *node[K, V], key K) (root *node[K, V], value V, existed bool) {
+	if n == nil {
+		return nil, value, false
+	}
+
+	switch cmp.Compare(key, n.key) {
+	case -1:
+		n.left, value, existed = remove(n.left, key)
+	case 1:
+		n.right, value, existed = remove(n.right, key)
+	default:
+		value = n.value
+
+		if n.left == nil {
+			return n.right, value, true
+		}
+
+		if n.right == nil {
+			return n.left, value, true
+		}
+
+		// Two children, replace this node with its in-order successor
+		successor := leftmost(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right, _, _ = remove(n.right, successor.key)
+
+		return rebalance(n), value, true
+	}
+
+	return rebalance(n), value, existed
+}
+
+// leftmost returns the node with the smallest key in the subtree rooted at n.
+func leftmost[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+
+	return n
+}
+
+// height returns the height of the subtree rooted at n, handling nil.
+func height[K cmp.Ordered, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+// update recalculates the height of n from its children.
+func update[K cmp.Ordered, V any](n *node[K, V]) {
+	n.height = 1 + max(height(n.left), height(n.right))
+}
+
+// rebalance restores the AVL invariant at n, returning the new root of the subtree.
+func rebalance[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	update(n)
+
+	switch balance := height(n.left) - height(n.right); {
+	case balance > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+
+		return rotateRight(n)
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+
+		return rotateLeft(n)
+	default:
+		return n
+	}
+}
+
+// rotateLeft rotates the subtree rooted at n to the left, returning the new root.
+func rotateLeft[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	root := n.right
+	n.right = root.left
+	root.left = n
+
+	update(n)
+	update(root)
+
+	return root
+}
+
+// rotateRight rotates the subtree rooted at n to the right, returning the new root.
+func rotateRight[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	root := n.left
+	n.left = root.right
+	root.right = n
+
+	update(n)
+	update(root)
+
+	return root
+}
+
+// walk does an in-order traversal of the subtree rooted at n, reporting
+// whether iteration should continue.
+func walk[K cmp.Ordered, V any](n *node[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return walk(n.left, yield) && yield(n.key, n.value) && walk(n.right, yield)
+}
+
+// walkRange does an in-order traversal of the subtree rooted at n, only visiting keys
+// in [lo, hi) and reporting whether iteration should continue.
+func walkRange[K cmp.Ordered, V any](n *node[K, V], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	aboveLo := cmp.Compare(n.key, lo) >= 0
+	belowHi := cmp.Compare(n.key, hi) < 0
+
+	if aboveLo && !walkRange(n.left, lo, hi, yield) {
+		return false
+	}
+
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+
+	if belowHi {
+		return walkRange(n.right, lo, hi, yield)
+	}
+
+	return true
+}
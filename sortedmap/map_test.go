@@ -0,0 +1,159 @@
+package sortedmap_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/sortedmap"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestInsertGet(t *testing.T) {
+	m := sortedmap.New[string, int]()
+	test.Equal(t, m.Size(), 0)
+
+	_, ok := m.Get("missing")
+	test.False(t, ok)
+
+	val, existed := m.Insert("one", 1)
+	test.False(t, existed)
+	test.Equal(t, val, 1)
+
+	m.Insert("two", 2)
+	m.Insert("three", 3)
+
+	test.Equal(t, m.Size(), 3)
+
+	two, ok := m.Get("two")
+	test.True(t, ok)
+	test.Equal(t, two, 2)
+
+	old, existed := m.Insert("two", 200)
+	test.True(t, existed)
+	test.Equal(t, old, 2)
+	test.Equal(t, m.Size(), 3)
+
+	test.True(t, m.Contains("three"))
+	test.False(t, m.Contains("four"))
+}
+
+func TestRemove(t *testing.T) {
+	m := sortedmap.New[int, string]()
+
+	_, existed := m.Remove(1)
+	test.False(t, existed)
+
+	m.Insert(2, "two")
+	m.Insert(1, "one")
+	m.Insert(3, "three")
+
+	two, existed := m.Remove(2) // Node with two children
+	test.True(t, existed)
+	test.Equal(t, two, "two")
+	test.Equal(t, m.Size(), 2)
+	test.False(t, m.Contains(2))
+
+	test.EqualFunc(t, slices.Collect(m.Keys()), []int{1, 3}, slices.Equal)
+}
+
+func TestOrder(t *testing.T) {
+	m := sortedmap.New[int, int]()
+
+	// Lots of random inserts and removals to exercise the rebalancing
+	rng := rand.New(rand.NewPCG(1, 2))
+	want := make(map[int]int)
+
+	for range 1000 {
+		key := rng.IntN(200)
+		if rng.IntN(3) == 0 {
+			_, existed := m.Remove(key)
+			_, wanted := want[key]
+			test.Equal(t, existed, wanted)
+			delete(want, key)
+		} else {
+			m.Insert(key, key*10)
+			want[key] = key * 10
+		}
+	}
+
+	test.Equal(t, m.Size(), len(want))
+
+	keys := slices.Collect(m.Keys())
+	test.True(t, slices.IsSorted(keys))
+	test.Equal(t, len(keys), len(want))
+
+	for key, value := range m.All() {
+		test.Equal(t, value, want[key])
+	}
+
+	for value := range m.Values() {
+		test.Equal(t, value%10, 0)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	m := sortedmap.New[int, string]()
+
+	_, _, ok := m.Min()
+	test.False(t, ok)
+
+	_, _, ok = m.Max()
+	test.False(t, ok)
+
+	m.Insert(5, "five")
+	m.Insert(1, "one")
+	m.Insert(9, "nine")
+
+	key, value, ok := m.Min()
+	test.True(t, ok)
+	test.Equal(t, key, 1)
+	test.Equal(t, value, "one")
+
+	key, value, ok = m.Max()
+	test.True(t, ok)
+	test.Equal(t, key, 9)
+	test.Equal(t, value, "nine")
+}
+
+func TestRange(t *testing.T) {
+	m := sortedmap.New[int, string]()
+	for i := range 10 {
+		m.Insert(i, "")
+	}
+
+	var got []int
+	for key := range m.Range(3, 7) {
+		got = append(got, key)
+	}
+
+	test.EqualFunc(t, got, []int{3, 4, 5, 6}, slices.Equal)
+
+	// Early return
+	got = nil
+
+	for key := range m.Range(0, 10) {
+		if key == 2 {
+			break
+		}
+
+		got = append(got, key)
+	}
+
+	test.EqualFunc(t, got, []int{0, 1}, slices.Equal)
+
+	// Empty range
+	for range m.Range(7, 3) {
+		t.Fatal("Range yielded for an empty interval")
+	}
+}
+
+func BenchmarkInsert(b *testing.B) {
+	m := sortedmap.New[int, int]()
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		m.Insert(i, i)
+	}
+}
@@ -1,13 +1,14 @@
 // Package stack implements a LIFO stack generic over any type.
 //
 // The stack is not safe for concurrent access across goroutines, the caller is responsible for
-// synchronising concurrent access.
+// synchronising concurrent access, or may use [SyncStack] which does this for them.
 package stack
 
 import (
 	"errors"
 	"fmt"
 	"iter"
+	"slices"
 )
 
 // Stack is a LIFO stack generic over any type.
@@ -60,6 +61,20 @@ func (s *Stack[T]) Push(item T) {
 	s.container = append(s.container, item)
 }
 
+// PushMany adds a number of items to the top of the stack, in the order
+// they are given (so the last item ends up on top).
+//
+// The stack is grown at most once to fit all the items, making this more efficient
+// than calling [Stack.Push] in a loop.
+//
+//	s := stack.New[string]()
+//	s.PushMany("hello", "there")
+//	item, _ := s.Pop()
+//	fmt.Println(item) // "there"
+func (s *Stack[T]) PushMany(items ...T) {
+	s.container = append(slices.Grow(s.container, len(items)), items...)
+}
+
 // Pop removes an item from the top of the stack, if the stack
 // is empty, an error will be returned.
 //
@@ -85,6 +100,131 @@ func (s *Stack[T]) Pop() (T, error) {
 	return item, nil
 }
 
+// Peek returns the item at the top of the stack without removing it, if the
+// stack is empty, an error will be returned.
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	s.Push("there")
+//	item, _ := s.Peek()
+//	fmt.Println(item) // "there"
+//	s.Size() // 2
+func (s *Stack[T]) Peek() (T, error) {
+	l := len(s.container)
+	if l == 0 {
+		var none T
+
+		return none, errors.New("peek from empty stack")
+	}
+
+	return s.container[l-1], nil
+}
+
+// PopN removes n items from the top of the stack, returning them in the order
+// they were popped (i.e. the item from the top of the stack first).
+//
+// If the stack contains fewer than n items, an error will be returned and the
+// stack is left unmodified.
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	s.Push("there")
+//	s.Push("general")
+//
+//	items, _ := s.PopN(2)
+//	fmt.Println(items) // [general there]
+func (s *Stack[T]) PopN(n int) ([]T, error) {
+	l := len(s.container)
+	if n < 0 || n > l {
+		return nil, fmt.Errorf("cannot pop %d items from stack of size %d", n, l)
+	}
+
+	items := slices.Clone(s.container[l-n:])
+	slices.Reverse(items)
+
+	clear(s.container[l-n:]) // Zero the popped items so they may be garbage collected
+	s.container = s.container[:l-n]
+
+	return items, nil
+}
+
+// Truncate discards all items above the given size, leaving the bottom size
+// items on the stack.
+//
+// If size is negative or greater than the current size of the stack, an error
+// will be returned and the stack is left unmodified.
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	s.Push("there")
+//	s.Push("general")
+//	s.Truncate(1)
+//	s.Size() // 1
+func (s *Stack[T]) Truncate(size int) error {
+	l := len(s.container)
+	if size < 0 || size > l {
+		return fmt.Errorf("cannot truncate stack of size %d to %d", l, size)
+	}
+
+	clear(s.container[size:]) // Zero the discarded items so they may be garbage collected
+	s.container = s.container[:size]
+
+	return nil
+}
+
+// Clear removes all the items from the stack, retaining the allocated capacity
+// so the stack may be reused without the need for reallocation.
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	s.Push("there")
+//	s.Clear()
+//	s.IsEmpty() // true
+func (s *Stack[T]) Clear() {
+	clear(s.container) // Zero the items so they may be garbage collected
+	s.container = s.container[:0]
+}
+
+// Clone returns a new [Stack] containing a copy of the items in s.
+//
+// The returned stack is entirely independent of s, mutating one
+// will not affect the other.
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	other := s.Clone()
+//	other.Push("there")
+//	s.Size() // 1
+//	other.Size() // 2
+func (s *Stack[T]) Clone() *Stack[T] {
+	return &Stack[T]{container: slices.Clone(s.container)}
+}
+
+// Reverse reverses the order of the items in the stack in place, so the
+// item at the bottom of the stack is now at the top.
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	s.Push("there")
+//	s.Reverse()
+//	item, _ := s.Pop()
+//	fmt.Println(item) // "hello"
+func (s *Stack[T]) Reverse() {
+	slices.Reverse(s.container)
+}
+
+// Grow increases the capacity of the stack, if necessary, to guarantee space
+// for another n items to be pushed without the need for reallocation.
+//
+// If n is negative, Grow will panic.
+//
+//	s := stack.New[string]()
+//	s.Grow(10)
+//	s.Capacity() // 10
+func (s *Stack[T]) Grow(n int) {
+	s.container = slices.Grow(s.container, n)
+}
+
 // Size returns the number of items in the stack.
 //
 //	s := stack.New[string]()
@@ -95,6 +235,15 @@ func (s *Stack[T]) Size() int {
 	return len(s.container)
 }
 
+// Len returns the number of items currently in the stack.
+//
+// It is equivalent to [Stack.Size] and allows a Stack to be used as a [collections.Collection].
+//
+// [collections.Collection]: https://pkg.go.dev/github.com/FollowTheProcess/collections#Collection
+func (s *Stack[T]) Len() int {
+	return len(s.container)
+}
+
 // Capacity returns the capacity of the stack, i.e. the number of items
 // it can contain without the need for reallocation.
 //
@@ -132,7 +281,68 @@ func (s *Stack[T]) All() iter.Seq[T] {
 	}
 }
 
+// Drain returns an iterator that pops items from the stack in LIFO order,
+// consuming the stack as it goes.
+//
+// Unlike [Stack.All], the stack is modified by iteration. If iteration stops early,
+// any items not yet yielded remain on the stack.
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	s.Push("there")
+//	slices.Collect(s.Drain()) // [there hello]
+//	s.IsEmpty() // true
+func (s *Stack[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !s.IsEmpty() {
+			item, _ := s.Pop() //nolint: errcheck // Only error is pop from empty stack
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a copy of the items in the stack, ordered from the bottom
+// of the stack to the top (i.e. the order in which they were pushed).
+//
+// Modifying the returned slice does not affect the stack. To get the items
+// in LIFO order, use slices.Collect(s.All()).
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	s.Push("there")
+//	s.ToSlice() // [hello there]
+func (s *Stack[T]) ToSlice() []T {
+	return slices.Clone(s.container)
+}
+
 // String satisfies the [fmt.Stringer] interface and allows a stack to print itself.
 func (s *Stack[T]) String() string {
 	return fmt.Sprintf("%v", s.container)
 }
+
+// Contains reports whether the stack contains target.
+//
+// It is a function rather than a method as it requires the items
+// in the stack to be comparable.
+//
+//	s := stack.New[string]()
+//	s.Push("hello")
+//	stack.Contains(s, "hello") // true
+//	stack.Contains(s, "there") // false
+func Contains[T comparable](s *Stack[T], target T) bool {
+	return slices.Contains(s.container, target)
+}
+
+// Equal returns whether two stacks are equal to one another, i.e. they contain
+// exactly the same items in the same order.
+//
+// If either of the two stacks are nil, Equal returns false.
+func Equal[T comparable](a, b *Stack[T]) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	return slices.Equal(a.container, b.container)
+}
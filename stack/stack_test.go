@@ -23,6 +23,18 @@ func TestIsEmpty(t *testing.T) {
 	test.False(t, s.IsEmpty()) // Empty should not be false
 }
 
+func TestPushMany(t *testing.T) {
+	s := stack.New[string]()
+	s.PushMany() // Nothing should happen
+	test.True(t, s.IsEmpty())
+
+	s.Push("hello")
+	s.PushMany("there", "general", "kenobi")
+
+	test.Equal(t, s.Size(), 4)
+	test.EqualFunc(t, slices.Collect(s.All()), []string{"kenobi", "general", "there", "hello"}, slices.Equal)
+}
+
 func TestPop(t *testing.T) {
 	s := stack.New[string]()
 	s.Push("hello")
@@ -52,6 +64,75 @@ func TestPop(t *testing.T) {
 	test.Equal(t, item, "") // Item should be the zero value
 }
 
+func TestPeek(t *testing.T) {
+	s := stack.New[string]()
+
+	_, err := s.Peek()
+	test.Err(t, err) // Peek from empty stack should error
+
+	s.Push("hello")
+	s.Push("there")
+
+	item, err := s.Peek()
+	test.Ok(t, err)
+	test.Equal(t, item, "there")
+
+	test.Equal(t, s.Size(), 2) // Peek should not remove the item
+}
+
+func TestPopN(t *testing.T) {
+	s := stack.From([]string{"hello", "there", "general", "kenobi"})
+
+	items, err := s.PopN(2)
+	test.Ok(t, err)
+	test.EqualFunc(t, items, []string{"kenobi", "general"}, slices.Equal)
+	test.Equal(t, s.Size(), 2)
+
+	none, err := s.PopN(0)
+	test.Ok(t, err)
+	test.Equal(t, len(none), 0)
+
+	// Too many, should error and leave the stack alone
+	_, err = s.PopN(3)
+	test.Err(t, err)
+	test.Equal(t, s.Size(), 2)
+
+	_, err = s.PopN(-1)
+	test.Err(t, err)
+
+	items, err = s.PopN(2)
+	test.Ok(t, err)
+	test.EqualFunc(t, items, []string{"there", "hello"}, slices.Equal)
+	test.True(t, s.IsEmpty())
+}
+
+func TestTruncate(t *testing.T) {
+	s := stack.From([]string{"hello", "there", "general", "kenobi"})
+
+	err := s.Truncate(4)
+	test.Ok(t, err) // Truncate to current size is a no-op
+	test.Equal(t, s.Size(), 4)
+
+	err = s.Truncate(5)
+	test.Err(t, err) // Bigger than the stack
+	test.Equal(t, s.Size(), 4)
+
+	err = s.Truncate(-1)
+	test.Err(t, err)
+
+	err = s.Truncate(2)
+	test.Ok(t, err)
+	test.Equal(t, s.Size(), 2)
+
+	item, err := s.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "there")
+
+	err = s.Truncate(0)
+	test.Ok(t, err)
+	test.True(t, s.IsEmpty())
+}
+
 func TestNotNew(t *testing.T) {
 	s := stack.Stack[int]{}
 	s.Push(1)
@@ -77,6 +158,159 @@ func TestCapacity(t *testing.T) {
 	test.Equal(t, s.Capacity(), 10)
 }
 
+func TestGrow(t *testing.T) {
+	s := stack.New[int]()
+	s.Push(1)
+	s.Grow(10)
+
+	test.True(t, s.Capacity() >= 11)
+	test.Equal(t, s.Size(), 1) // Grow should not add items
+
+	// Already enough room, should be a no-op
+	before := s.Capacity()
+	s.Grow(5)
+	test.Equal(t, s.Capacity(), before)
+}
+
+func TestClear(t *testing.T) {
+	s := stack.WithCapacity[string](10)
+	s.Push("hello")
+	s.Push("there")
+	s.Push("general")
+	s.Push("kenobi")
+
+	test.Equal(t, s.Size(), 4)
+
+	s.Clear()
+
+	test.True(t, s.IsEmpty())
+	test.Equal(t, s.Size(), 0)
+	test.Equal(t, s.Capacity(), 10) // Clear should retain capacity
+
+	// Should be reusable
+	s.Push("again")
+
+	item, err := s.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "again")
+}
+
+func TestClone(t *testing.T) {
+	s := stack.New[string]()
+	s.Push("hello")
+	s.Push("there")
+
+	other := s.Clone()
+	test.EqualFunc(t, slices.Collect(other.All()), slices.Collect(s.All()), slices.Equal)
+
+	// Mutating one should not affect the other
+	other.Push("general")
+
+	item, err := s.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "there")
+
+	test.Equal(t, s.Size(), 1)
+	test.Equal(t, other.Size(), 3)
+
+	top, err := other.Pop()
+	test.Ok(t, err)
+	test.Equal(t, top, "general")
+
+	top, err = other.Pop()
+	test.Ok(t, err)
+	test.Equal(t, top, "there") // Clone should be unaffected by the Pop on the original
+}
+
+func TestContains(t *testing.T) {
+	s := stack.New[string]()
+	test.False(t, stack.Contains(s, "hello")) // Empty stack contains nothing
+
+	s.Push("hello")
+	s.Push("there")
+	s.Push("general")
+
+	test.True(t, stack.Contains(s, "hello"))
+	test.True(t, stack.Contains(s, "general"))
+	test.False(t, stack.Contains(s, "kenobi"))
+
+	// Popped items should no longer be found
+	_, err := s.Pop()
+	test.Ok(t, err)
+	test.False(t, stack.Contains(s, "general"))
+}
+
+func TestReverse(t *testing.T) {
+	s := stack.From([]string{"hello", "there", "general", "kenobi"})
+	s.Reverse()
+
+	test.Equal(t, s.Size(), 4)
+
+	item, err := s.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "hello") // Used to be the bottom
+
+	item, err = s.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "there")
+
+	// Reversing an empty stack should be fine
+	empty := stack.New[string]()
+	empty.Reverse()
+	test.True(t, empty.IsEmpty())
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b *stack.Stack[string] // Stacks to compare
+		name string               // Name of the test case
+		want bool                 // Whether they should be considered equal
+	}{
+		{
+			name: "nil",
+			a:    nil,
+			b:    nil,
+			want: false,
+		},
+		{
+			name: "one nil",
+			a:    stack.New[string](),
+			b:    nil,
+			want: false,
+		},
+		{
+			name: "empty",
+			a:    stack.New[string](),
+			b:    stack.WithCapacity[string](10),
+			want: true,
+		},
+		{
+			name: "same",
+			a:    stack.From([]string{"hello", "there"}),
+			b:    stack.From([]string{"hello", "there"}),
+			want: true,
+		},
+		{
+			name: "different order",
+			a:    stack.From([]string{"hello", "there"}),
+			b:    stack.From([]string{"there", "hello"}),
+			want: false,
+		},
+		{
+			name: "different size",
+			a:    stack.From([]string{"hello", "there"}),
+			b:    stack.From([]string{"hello", "there", "general"}),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.Equal(t, stack.Equal(tt.a, tt.b), tt.want)
+		})
+	}
+}
+
 func TestItems(t *testing.T) {
 	s := stack.New[string]()
 	s.Push("hello")
@@ -90,6 +324,48 @@ func TestItems(t *testing.T) {
 	test.EqualFunc(t, got, want, slices.Equal)
 }
 
+func TestDrain(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		s := stack.From([]string{"hello", "there", "general", "kenobi"})
+
+		got := slices.Collect(s.Drain())
+		want := []string{"kenobi", "general", "there", "hello"}
+
+		test.EqualFunc(t, got, want, slices.Equal)
+		test.True(t, s.IsEmpty()) // Drain should consume the stack
+	})
+
+	t.Run("early return", func(t *testing.T) {
+		s := stack.From([]string{"hello", "there", "general", "kenobi"})
+
+		for item := range s.Drain() {
+			if item == "general" {
+				break
+			}
+		}
+
+		want := []string{"there", "hello"}
+		test.EqualFunc(t, slices.Collect(s.All()), want, slices.Equal)
+	})
+}
+
+func TestToSlice(t *testing.T) {
+	s := stack.New[string]()
+	s.Push("hello")
+	s.Push("there")
+	s.Push("general")
+
+	got := s.ToSlice()
+	test.EqualFunc(t, got, []string{"hello", "there", "general"}, slices.Equal) // Bottom to top
+
+	// Modifying the slice should not affect the stack
+	got[2] = "kenobi"
+
+	item, err := s.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "general")
+}
+
 func TestString(t *testing.T) {
 	s := stack.New[string]()
 	s.Push("hello")
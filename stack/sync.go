@@ -0,0 +1,80 @@
+package stack
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// SyncStack is a LIFO [Stack] that is safe for concurrent access across goroutines.
+//
+// Reads take a shared read lock and writes take an exclusive write lock, the zero
+// value is an empty stack ready to use.
+type SyncStack[T any] struct {
+	stack Stack[T]     // The wrapped stack
+	mu    sync.RWMutex // Guards stack
+}
+
+// NewSync constructs and returns a new [SyncStack].
+func NewSync[T any]() *SyncStack[T] {
+	return &SyncStack[T]{
+		stack: Stack[T]{container: make([]T, 0)},
+	}
+}
+
+// Push adds an item to the top of stack.
+func (s *SyncStack[T]) Push(item T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stack.Push(item)
+}
+
+// Pop removes an item from the top of the stack, if the stack
+// is empty, an error will be returned.
+func (s *SyncStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Pop()
+}
+
+// Peek returns the item at the top of the stack without removing it, if the
+// stack is empty, an error will be returned.
+func (s *SyncStack[T]) Peek() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Peek()
+}
+
+// Size returns the number of items in the stack.
+func (s *SyncStack[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.Size()
+}
+
+// IsEmpty returns whether or not the stack is empty.
+func (s *SyncStack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.stack.IsEmpty()
+}
+
+// All returns an iterator over a snapshot of the stack in LIFO order, taken
+// at the time All is called.
+//
+// The lock is not held during iteration, so the stack may be freely modified
+// while iterating, but those modifications will not be reflected in the iterator.
+func (s *SyncStack[T]) All() iter.Seq[T] {
+	s.mu.RLock()
+	snapshot := slices.Clone(s.stack.container)
+	s.mu.RUnlock()
+
+	slices.Reverse(snapshot)
+
+	return slices.Values(snapshot)
+}
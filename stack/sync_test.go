@@ -0,0 +1,74 @@
+package stack_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/stack"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestSyncStack(t *testing.T) {
+	s := stack.NewSync[string]()
+	test.True(t, s.IsEmpty())
+
+	_, err := s.Pop()
+	test.Err(t, err)
+
+	_, err = s.Peek()
+	test.Err(t, err)
+
+	s.Push("hello")
+	s.Push("there")
+	test.Equal(t, s.Size(), 2)
+
+	top, err := s.Peek()
+	test.Ok(t, err)
+	test.Equal(t, top, "there")
+
+	item, err := s.Pop()
+	test.Ok(t, err)
+	test.Equal(t, item, "there")
+}
+
+func TestSyncStackSnapshot(t *testing.T) {
+	var s stack.SyncStack[string] // Zero value should be usable
+	s.Push("hello")
+	s.Push("there")
+
+	snapshot := s.All()
+
+	s.Push("general")
+
+	test.EqualFunc(t, slices.Collect(snapshot), []string{"there", "hello"}, slices.Equal)
+}
+
+func TestSyncStackConcurrent(t *testing.T) {
+	s := stack.NewSync[int]()
+
+	var wg sync.WaitGroup
+
+	for i := range 100 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			s.Push(i)
+			_, _ = s.Peek()
+
+			for range s.All() {
+				// Modifying during iteration should not deadlock
+				_, _ = s.Pop()
+				s.Push(i)
+
+				break
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	test.Equal(t, s.Size(), 100)
+}
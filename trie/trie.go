@@ -0,0 +1,231 @@
+// Package trie implements a generic prefix tree keyed on strings, useful for
+// efficient prefix queries such as autocompletion.
+//
+// The trie is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package trie
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// node is a single node in the trie.
+type node[V any] struct {
+	children map[rune]*node[V] // The child nodes, keyed by the next rune
+	value    V                 // The value stored against the key ending at this node
+	terminal bool              // Whether a key ends at this node
+}
+
+// newNode creates and returns a new, empty node.
+func newNode[V any]() *node[V] {
+	return &node[V]{children: make(map[rune]*node[V])}
+}
+
+// Trie is a prefix tree, mapping string keys to values of type V.
+type Trie[V any] struct {
+	root *node[V] // The root node, representing the empty string
+	size int      // The number of keys in the trie
+}
+
+// New creates and returns a new, empty [Trie].
+func New[V any]() *Trie[V] {
+	return &Trie[V]{root: newNode[V]()}
+}
+
+// Insert inserts a new value into the trie against the given key, returning the previous
+// value and a boolean to indicate presence.
+//
+// If the trie did not have this key present before the call to Insert, it will return the
+// value just inserted and false.
+//
+// If the trie did have this key, and this call to Insert is therefore an update of an existing value,
+// then the old value and true are returned.
+func (t *Trie[V]) Insert(key string, value V) (val V, existed bool) {
+	// nil safety
+	if t.root == nil {
+		t.root = newNode[V]()
+	}
+
+	current := t.root
+	for _, char := range key {
+		child, exists := current.children[char]
+		if !exists {
+			child = newNode[V]()
+			current.children[char] = child
+		}
+
+		current = child
+	}
+
+	if current.terminal {
+		old := current.value
+		current.value = value
+
+		return old, true
+	}
+
+	current.value = value
+	current.terminal = true
+	t.size++
+
+	return value, false
+}
+
+// Get returns the value stored against the given key in the trie and a boolean
+// to indicate presence, like the standard Go map.
+func (t *Trie[V]) Get(key string) (value V, ok bool) {
+	found := t.find(key)
+	if found == nil || !found.terminal {
+		return value, false
+	}
+
+	return found.value, true
+}
+
+// Contains reports whether the trie contains the exact given key.
+func (t *Trie[V]) Contains(key string) bool {
+	_, ok := t.Get(key)
+
+	return ok
+}
+
+// Remove removes a key from the trie, returning the stored value and
+// a boolean to indicate whether it was in the trie to begin with.
+func (t *Trie[V]) Remove(key string) (value V, existed bool) {
+	if t.root == nil {
+		return value, false
+	}
+
+	// Keep track of the path so we can prune nodes that are no longer needed
+	path := make([]*node[V], 0, len(key)+1)
+	runes := make([]rune, 0, len(key))
+
+	current := t.root
+	path = append(path, current)
+
+	for _, char := range key {
+		child, exists := current.children[char]
+		if !exists {
+			return value, false
+		}
+
+		current = child
+
+		path = append(path, current)
+		runes = append(runes, char)
+	}
+
+	if !current.terminal {
+		return value, false
+	}
+
+	value = current.value
+
+	var zero V
+
+	current.value = zero
+	current.terminal = false
+	t.size--
+
+	// Walk back up, removing any nodes that no longer lead anywhere
+	for i := len(path) - 1; i > 0; i-- {
+		if path[i].terminal || len(path[i].children) != 0 {
+			break
+		}
+
+		delete(path[i-1].children, runes[i-1])
+	}
+
+	return value, true
+}
+
+// HasPrefix reports whether the trie contains any key starting with prefix.
+//
+// The empty prefix matches any key, so HasPrefix("") reports whether the trie
+// is non-empty.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	found := t.find(prefix)
+	if found == nil {
+		return false
+	}
+
+	// Nodes are pruned on removal so any node still present leads to at least
+	// one key, except the root which is always present
+	return found.terminal || len(found.children) != 0
+}
+
+// WithPrefix returns an iterator over all the key, value pairs in the trie whose key
+// starts with prefix, yielding them in lexicographic order of key.
+func (t *Trie[V]) WithPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		start := t.find(prefix)
+		if start == nil {
+			return
+		}
+
+		var builder strings.Builder
+		builder.WriteString(prefix)
+
+		walk(start, &builder, yield)
+	}
+}
+
+// All returns an iterator over all the key, value pairs in the trie, yielding
+// them in lexicographic order of key.
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.WithPrefix("")
+}
+
+// Size returns the number of keys in the trie.
+func (t *Trie[V]) Size() int {
+	return t.size
+}
+
+// find returns the node at the end of the path described by key, or nil
+// if there is no such node.
+func (t *Trie[V]) find(key string) *node[V] {
+	if t.root == nil {
+		return nil
+	}
+
+	current := t.root
+	for _, char := range key {
+		child, exists := current.children[char]
+		if !exists {
+			return nil
+		}
+
+		current = child
+	}
+
+	return current
+}
+
+// walk does a depth first traversal of the trie from n, yielding each key
+// found in lexicographic order. The key so far is held in builder.
+//
+// It reports whether iteration should continue.
+func walk[V any](n *node[V], builder *strings.Builder, yield func(string, V) bool) bool {
+	if n.terminal {
+		if !yield(builder.String(), n.value) {
+			return false
+		}
+	}
+
+	key := builder.String()
+
+	for _, char := range slices.Sorted(maps.Keys(n.children)) {
+		builder.Reset()
+		builder.WriteString(key)
+		builder.WriteRune(char)
+
+		if !walk(n.children[char], builder, yield) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,132 @@
+package trie_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/FollowTheProcess/collections/trie"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestInsertGet(t *testing.T) {
+	tr := trie.New[int]()
+	test.Equal(t, tr.Size(), 0)
+
+	val, existed := tr.Insert("car", 1)
+	test.False(t, existed)
+	test.Equal(t, val, 1)
+
+	tr.Insert("cart", 2)
+	tr.Insert("cat", 3)
+
+	test.Equal(t, tr.Size(), 3)
+
+	got, ok := tr.Get("car")
+	test.True(t, ok)
+	test.Equal(t, got, 1)
+
+	_, ok = tr.Get("ca") // Prefix, but not a key
+	test.False(t, ok)
+
+	_, ok = tr.Get("dog")
+	test.False(t, ok)
+
+	old, existed := tr.Insert("car", 100)
+	test.True(t, existed)
+	test.Equal(t, old, 1)
+	test.Equal(t, tr.Size(), 3)
+
+	test.True(t, tr.Contains("cart"))
+	test.False(t, tr.Contains("carts"))
+}
+
+func TestHasPrefix(t *testing.T) {
+	tr := trie.New[int]()
+	test.False(t, tr.HasPrefix(""))
+
+	tr.Insert("hello", 1)
+	tr.Insert("héllo", 2)
+
+	test.True(t, tr.HasPrefix(""))
+	test.True(t, tr.HasPrefix("he"))
+	test.True(t, tr.HasPrefix("hé"))
+	test.True(t, tr.HasPrefix("hello"))
+	test.False(t, tr.HasPrefix("hello!"))
+	test.False(t, tr.HasPrefix("x"))
+}
+
+func TestWithPrefix(t *testing.T) {
+	tr := trie.New[int]()
+	tr.Insert("cat", 3)
+	tr.Insert("car", 1)
+	tr.Insert("dog", 4)
+	tr.Insert("cart", 2)
+
+	var keys []string
+
+	for key := range tr.WithPrefix("ca") {
+		keys = append(keys, key)
+	}
+
+	test.EqualFunc(t, keys, []string{"car", "cart", "cat"}, slices.Equal)
+
+	keys = nil
+	for key := range tr.All() {
+		keys = append(keys, key)
+	}
+
+	test.EqualFunc(t, keys, []string{"car", "cart", "cat", "dog"}, slices.Equal)
+
+	// Early return
+	keys = nil
+
+	for key := range tr.All() {
+		keys = append(keys, key)
+		if key == "cart" {
+			break
+		}
+	}
+
+	test.EqualFunc(t, keys, []string{"car", "cart"}, slices.Equal)
+
+	// Missing prefix
+	for range tr.WithPrefix("x") {
+		t.Fatal("WithPrefix yielded for a missing prefix")
+	}
+}
+
+func TestRemove(t *testing.T) {
+	tr := trie.New[int]()
+	tr.Insert("car", 1)
+	tr.Insert("cart", 2)
+
+	_, existed := tr.Remove("ca")
+	test.False(t, existed) // Not a key
+
+	value, existed := tr.Remove("cart")
+	test.True(t, existed)
+	test.Equal(t, value, 2)
+
+	test.False(t, tr.HasPrefix("cart")) // Should be pruned
+	test.True(t, tr.HasPrefix("car"))
+
+	value, existed = tr.Remove("car")
+	test.True(t, existed)
+	test.Equal(t, value, 1)
+
+	test.Equal(t, tr.Size(), 0)
+	test.False(t, tr.HasPrefix("c"))
+}
+
+func TestNotNew(t *testing.T) {
+	var tr trie.Trie[int]
+
+	_, ok := tr.Get("missing")
+	test.False(t, ok)
+
+	tr.Insert("one", 1)
+
+	got, ok := tr.Get("one")
+	test.True(t, ok)
+	test.Equal(t, got, 1)
+}
@@ -0,0 +1,170 @@
+// Package ttlcache implements a generic cache whose entries expire after a time to live (TTL).
+//
+// Expired entries are treated as absent and are evicted lazily when they are accessed, or
+// all at once by calling [Cache.Sweep].
+//
+// The cache is not safe for concurrent access across goroutines, the caller is responsible for
+// synchronising concurrent access.
+package ttlcache
+
+import (
+	"time"
+
+	"github.com/FollowTheProcess/collections/orderedmap"
+)
+
+// entry is a single value in the cache, along with when it expires.
+type entry[V any] struct {
+	expires time.Time // When the entry expires, the zero time means never
+	value   V         // The cached value
+	custom  bool      // Whether the entry was put with a TTL other than the cache's default
+}
+
+// expired reports whether the entry has expired at the given time.
+func (e entry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// Cache is a cache whose entries expire after a time to live.
+//
+// A Cache should be instantiated by the New function and not directly.
+type Cache[K comparable, V any] struct {
+	entries *orderedmap.Map[K, entry[V]] // Entries ordered from least to most recently put
+	ttl     time.Duration                // The default time to live for entries, 0 means never expire
+	custom  int                          // Number of entries put with a TTL other than the default
+}
+
+// New creates and returns a new [Cache] where entries expire ttl after they are put.
+//
+// A ttl <= 0 means entries never expire unless put with [Cache.PutWithTTL].
+func New[K comparable, V any](ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		entries: orderedmap.New[K, entry[V]](),
+		ttl:     max(ttl, 0),
+	}
+}
+
+// Get returns the value stored against key and a boolean to indicate presence, like
+// the standard Go map.
+//
+// An expired entry is treated as absent and evicted from the cache.
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	e, ok := c.entries.Get(key)
+	if !ok {
+		return value, false
+	}
+
+	if e.expired(time.Now()) {
+		c.remove(key)
+
+		return value, false
+	}
+
+	return e.value, true
+}
+
+// Contains reports whether key is in the cache and has not expired.
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, ok := c.Get(key)
+
+	return ok
+}
+
+// Put stores value against key in the cache, expiring after the cache's default TTL.
+//
+// If key is already present, its value is replaced and its expiry reset.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.ttl)
+}
+
+// PutWithTTL stores value against key in the cache, expiring after ttl rather
+// than the cache's default.
+//
+// A ttl <= 0 means the entry never expires.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	ttl = max(ttl, 0)
+
+	e := entry[V]{value: value, custom: ttl != c.ttl}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+
+	if e.custom {
+		c.custom++
+	}
+
+	// An update also moves the key to the newest end
+	if old, existed := c.entries.InsertBump(key, e); existed && old.custom {
+		c.custom--
+	}
+}
+
+// Remove removes key from the cache, returning the stored value and a boolean
+// to indicate whether it was in the cache (and had not expired) to begin with.
+func (c *Cache[K, V]) Remove(key K) (value V, existed bool) {
+	e, existed := c.remove(key)
+	if !existed || e.expired(time.Now()) {
+		return value, false
+	}
+
+	return e.value, true
+}
+
+// Sweep evicts every expired entry from the cache, returning how many were evicted.
+//
+// Expired entries are evicted lazily on access anyway, Sweep is useful to reclaim
+// the memory held by entries that are never accessed again.
+//
+// While every entry in the cache was put with the default TTL, entries expire in the
+// order they were put, so Sweep only visits the expired entries plus one. Once any entry
+// has been put with a different TTL, Sweep must check every entry and is O(n).
+func (c *Cache[K, V]) Sweep() int {
+	now := time.Now()
+
+	if c.custom == 0 {
+		evicted := 0
+
+		for {
+			_, oldest, ok := c.entries.Oldest()
+			if !ok || !oldest.expired(now) {
+				return evicted
+			}
+
+			c.entries.PopOldest()
+
+			evicted++
+		}
+	}
+
+	var expired []K
+
+	for key, e := range c.entries.All() {
+		if e.expired(now) {
+			expired = append(expired, key)
+		}
+	}
+
+	for _, key := range expired {
+		c.remove(key)
+	}
+
+	return len(expired)
+}
+
+// Len returns the number of entries currently held in the cache.
+//
+// This includes expired entries that have not yet been evicted, call [Cache.Sweep]
+// first for an exact count of live entries.
+func (c *Cache[K, V]) Len() int {
+	return c.entries.Size()
+}
+
+// remove removes key from the entries, keeping track of the number of custom TTL entries.
+func (c *Cache[K, V]) remove(key K) (entry[V], bool) {
+	e, existed := c.entries.Remove(key)
+	if existed && e.custom {
+		c.custom--
+	}
+
+	return e, existed
+}
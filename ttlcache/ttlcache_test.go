@@ -0,0 +1,100 @@
+package ttlcache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FollowTheProcess/collections/ttlcache"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestPutGet(t *testing.T) {
+	c := ttlcache.New[string, int](time.Hour)
+
+	_, ok := c.Get("missing")
+	test.False(t, ok)
+
+	c.Put("one", 1)
+	c.Put("two", 2)
+	c.Put("one", 11) // Update
+
+	value, ok := c.Get("one")
+	test.True(t, ok)
+	test.Equal(t, value, 11)
+	test.True(t, c.Contains("two"))
+	test.Equal(t, c.Len(), 2)
+}
+
+func TestExpiry(t *testing.T) {
+	c := ttlcache.New[string, int](time.Nanosecond)
+
+	c.Put("short", 1)
+	c.PutWithTTL("long", 2, time.Hour)
+	c.PutWithTTL("forever", 3, 0)
+
+	time.Sleep(time.Millisecond)
+
+	_, ok := c.Get("short")
+	test.False(t, ok)         // Expired
+	test.Equal(t, c.Len(), 2) // And lazily evicted
+	test.True(t, c.Contains("long"))
+	test.True(t, c.Contains("forever"))
+}
+
+func TestSweep(t *testing.T) {
+	c := ttlcache.New[int, int](time.Nanosecond)
+	for i := range 10 {
+		c.Put(i, i)
+	}
+
+	c.PutWithTTL(100, 100, time.Hour)
+
+	time.Sleep(time.Millisecond)
+
+	test.Equal(t, c.Len(), 11)
+	test.Equal(t, c.Sweep(), 10)
+	test.Equal(t, c.Len(), 1)
+	test.True(t, c.Contains(100))
+}
+
+func TestSweepDefaultTTL(t *testing.T) {
+	c := ttlcache.New[int, int](50 * time.Millisecond)
+	for i := range 5 {
+		c.Put(i, i)
+	}
+
+	c.PutWithTTL(100, 100, time.Hour)
+	c.Remove(100) // Back to only default TTL entries
+
+	time.Sleep(60 * time.Millisecond)
+
+	for i := 5; i < 10; i++ {
+		c.Put(i, i)
+	}
+
+	c.Put(0, 0) // Refreshes 0, moving it to the newest end
+
+	test.Equal(t, c.Sweep(), 4) // Only 1 to 4 have expired
+	test.Equal(t, c.Len(), 6)
+	test.True(t, c.Contains(0))
+	test.True(t, c.Contains(9))
+}
+
+func TestRemove(t *testing.T) {
+	c := ttlcache.New[string, int](time.Hour)
+	c.Put("one", 1)
+
+	value, existed := c.Remove("one")
+	test.True(t, existed)
+	test.Equal(t, value, 1)
+
+	_, existed = c.Remove("one")
+	test.False(t, existed)
+
+	c.PutWithTTL("short", 2, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	_, existed = c.Remove("short")
+	test.False(t, existed) // Expired counts as absent
+	test.Equal(t, c.Len(), 0)
+}
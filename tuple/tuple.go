@@ -0,0 +1,40 @@
+// Package tuple implements small generic product types for use wherever a function
+// needs to bundle values together, rather than inventing an ad-hoc struct each time.
+package tuple
+
+import "fmt"
+
+// Pair is a generic 2-tuple.
+type Pair[A, B any] struct {
+	First  A // The first element
+	Second B // The second element
+}
+
+// MakePair builds and returns a [Pair] from first and second.
+//
+//	p := tuple.MakePair("one", 1)
+//	p.First // "one"
+//	p.Second // 1
+func MakePair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Swap returns a new [Pair] with the elements of p swapped.
+//
+//	p := tuple.MakePair("one", 1)
+//	p.Swap() // (1, one)
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// Unpack returns the elements of the pair, useful for destructuring.
+//
+//	name, count := p.Unpack()
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// String implements [fmt.Stringer] for a [Pair] and allows it to print itself.
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
@@ -0,0 +1,27 @@
+package tuple_test
+
+import (
+	"testing"
+
+	"github.com/FollowTheProcess/collections/tuple"
+	"github.com/FollowTheProcess/test"
+)
+
+func TestPair(t *testing.T) {
+	p := tuple.MakePair("one", 1)
+	test.Equal(t, p.First, "one")
+	test.Equal(t, p.Second, 1)
+
+	swapped := p.Swap()
+	test.Equal(t, swapped.First, 1)
+	test.Equal(t, swapped.Second, "one")
+
+	name, count := p.Unpack()
+	test.Equal(t, name, "one")
+	test.Equal(t, count, 1)
+
+	test.Equal(t, p.String(), "(one, 1)")
+
+	// Pairs of comparable types should be comparable
+	test.Equal(t, p, tuple.Pair[string, int]{First: "one", Second: 1})
+}